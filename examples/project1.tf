@@ -2,3 +2,112 @@ resource "pivotaltracker_project" "test_project" {
    name  = "some_new_project"
    description = "change description again"
 }
+
+resource "pivotaltracker_account_member" "test_member" {
+   account_id      = "${pivotaltracker_project.test_project.account_id}"
+   email           = "someone@example.com"
+   name            = "Some One"
+   initials        = "SO"
+   project_creator = true
+}
+
+resource "pivotaltracker_project_membership" "test_membership" {
+   project_id = "${pivotaltracker_project.test_project.id}"
+   person_id  = "${pivotaltracker_account_member.test_member.person_id}"
+   role       = "member"
+}
+
+resource "pivotaltracker_label" "tech_debt" {
+   project_id = "${pivotaltracker_project.test_project.id}"
+   name       = "tech-debt"
+}
+
+resource "pivotaltracker_epic" "onboarding" {
+   project_id  = "${pivotaltracker_project.test_project.id}"
+   name        = "Q3 Onboarding"
+   description = "make signing up painless"
+   label       = "q3-onboarding"
+}
+
+resource "pivotaltracker_story" "rotate_certificates" {
+   project_id = "${pivotaltracker_project.test_project.id}"
+   name       = "rotate certificates"
+   story_type = "chore"
+   labels     = ["security"]
+}
+
+resource "pivotaltracker_task" "renew_wildcard" {
+   project_id  = "${pivotaltracker_project.test_project.id}"
+   story_id    = "${element(split("/", pivotaltracker_story.rotate_certificates.id), 1)}"
+   description = "renew the wildcard certificate"
+   position    = 1
+}
+
+resource "pivotaltracker_webhook" "activity" {
+   project_id  = "${pivotaltracker_project.test_project.id}"
+   webhook_url = "https://activity.example.com/tracker"
+}
+
+variable "jira_password" {}
+
+resource "pivotaltracker_integration" "issues" {
+   project_id = "${pivotaltracker_project.test_project.id}"
+   type       = "jira"
+   name       = "issues"
+
+   jira {
+      base_url     = "https://example.atlassian.net"
+      api_username = "tracker-bot"
+      api_password = "${var.jira_password}"
+   }
+}
+
+resource "pivotaltracker_iteration_override" "offsite" {
+   project_id    = "${pivotaltracker_project.test_project.id}"
+   number        = 12
+   team_strength = 0.6
+}
+
+resource "pivotaltracker_workspace" "team" {
+   name        = "platform team"
+   project_ids = ["${pivotaltracker_project.test_project.id}"]
+}
+
+# adopted with: terraform import pivotaltracker_account.acme <account_id>
+resource "pivotaltracker_account" "acme" {
+   name = "acme"
+}
+
+resource "pivotaltracker_review_type" "security" {
+   project_id = "${pivotaltracker_project.test_project.id}"
+   name       = "Security"
+}
+
+resource "pivotaltracker_story_review" "rotate_certificates_security" {
+   project_id     = "${pivotaltracker_project.test_project.id}"
+   story_id       = "${element(split("/", pivotaltracker_story.rotate_certificates.id), 1)}"
+   review_type_id = "${element(split("/", pivotaltracker_review_type.security.id), 1)}"
+}
+
+data "pivotaltracker_project" "shared" {
+   name       = "shared services"
+   account_id = "${pivotaltracker_project.test_project.account_id}"
+}
+
+data "pivotaltracker_projects" "platform" {
+   account_id = "${pivotaltracker_project.test_project.account_id}"
+   name_regex = "^platform-"
+}
+
+data "pivotaltracker_me" "current" {}
+
+data "pivotaltracker_person" "someone" {
+   account_id = "${pivotaltracker_project.test_project.account_id}"
+   email      = "someone@example.com"
+}
+
+data "pivotaltracker_account_members" "admins" {
+   account_id   = "${pivotaltracker_project.test_project.account_id}"
+   admin        = true
+   email_domain = "example.com"
+}
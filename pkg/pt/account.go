@@ -0,0 +1,79 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Account struct {
+	AccountRequest
+	Kind         string `json:"kind,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Plan         string `json:"plan,omitempty"`
+	DaysLeft     int    `json:"days_left,omitempty"`
+	OverTheLimit bool   `json:"over_the_limit,omitempty"`
+	ProjectIDs   []int  `json:"project_ids,omitempty"`
+}
+
+type AccountRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+// AccountCaller has no create or delete, tracker accounts can only be
+// opened and closed from the web interface.
+//
+//go:generate counterfeiter . AccountCaller
+type AccountCaller interface {
+	ListAccounts() ([]Account, *http.Response, error)
+	GetAccount(accountID int) (*Account, *http.Response, error)
+	UpdateAccount(accountID int, account AccountRequest) (*Account, *http.Response, error)
+}
+
+// ListAccounts - list all accounts the authenticated user is a member of
+func (service *Client) ListAccounts() ([]Account, *http.Response, error) {
+	req, err := service.NewRequest("GET", "accounts", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseAccounts := make([]Account, 0)
+	resp, err := service.Do(req, &responseAccounts)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseAccounts, resp, nil
+}
+
+// GetAccount - retrieve an account's details from the api
+func (service *Client) GetAccount(accountID int) (*Account, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("accounts/%v", accountID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseAccount := &Account{}
+	resp, err := service.Do(req, responseAccount)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseAccount, resp, nil
+}
+
+// UpdateAccount - updates the name of a given account.
+func (service *Client) UpdateAccount(accountID int, account AccountRequest) (*Account, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("accounts/%v", accountID), account)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseAccount := &Account{}
+	resp, err := service.Do(req, responseAccount)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseAccount, resp, nil
+}
@@ -0,0 +1,61 @@
+package pt_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestAccountClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("AccountCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlAccountID := 1234
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"UpdateAccount", fmt.Sprintf("accounts/%v", controlAccountID), "PUT", true, func() {
+					client.UpdateAccount(controlAccountID, pt.AccountRequest{})
+				}},
+				{"ListAccounts", "accounts", "GET", false, func() {
+					client.ListAccounts()
+				}},
+				{"GetAccount", fmt.Sprintf("accounts/%v", controlAccountID), "GET", false, func() {
+					client.GetAccount(controlAccountID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
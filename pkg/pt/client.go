@@ -1,8 +1,10 @@
 package pt
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/salsita/go-pivotaltracker/v5/pivotal"
 )
@@ -26,22 +28,43 @@ type ProjectRequest struct {
 	IterationLength              int               `json:"iteration_length,omitempty"`
 	WeekStartDay                 pivotal.Day       `json:"week_start_day,omitempty"`
 	PointScale                   string            `json:"point_scale,omitempty"`
-	BugsAndChoresAreEstimatable  bool              `json:"bugs_and_chores_are_estimatable,omitempty"`
-	AutomaticPlanning            bool              `json:"automatic_planning,omitempty"`
-	EnableTasks                  bool              `json:"enable_tasks,omitempty"`
+	BugsAndChoresAreEstimatable  *bool             `json:"bugs_and_chores_are_estimatable,omitempty"`
+	AutomaticPlanning            *bool             `json:"automatic_planning,omitempty"`
+	EnableTasks                  *bool             `json:"enable_tasks,omitempty"`
 	StartDate                    *pivotal.Date     `json:"start_date,omitempty"`
 	TimeZone                     *pivotal.TimeZone `json:"time_zone,omitempty"`
 	VelocityAveragedOver         int               `json:"velocity_averaged_over,omitempty"`
 	NumberOfDoneIterationsToShow int               `json:"number_of_done_iterations_to_show,omitempty"`
 	Description                  string            `json:"description,omitempty"`
 	ProfileContent               string            `json:"profile_content,omitempty"`
-	EnableIncomingEmails         bool              `json:"enable_incoming_emails,omitempty"`
+	EnableIncomingEmails         *bool             `json:"enable_incoming_emails,omitempty"`
 	InitialVelocity              int               `json:"initial_velocity,omitempty"`
 	ProjectType                  string            `json:"project_type,omitempty"`
-	Public                       bool              `json:"public,omitempty"`
-	AtomEnabled                  bool              `json:"atom_enabled,omitempty"`
+	Public                       *bool             `json:"public,omitempty"`
+	AtomEnabled                  *bool             `json:"atom_enabled,omitempty"`
 	AccountID                    int               `json:"account_id,omitempty"`
 	JoinAs                       string            `json:"join_as,omitempty"`
+	ClearDescription             bool              `json:"-"`
+	ClearProfileContent          bool              `json:"-"`
+}
+
+// MarshalJSON sends an empty description or profile_content when they are
+// cleared, which omitempty would otherwise leave out.
+func (project ProjectRequest) MarshalJSON() ([]byte, error) {
+	type projectRequest ProjectRequest
+	return marshalClearing(projectRequest(project), project.cleared())
+}
+
+func (project ProjectRequest) cleared() map[string]interface{} {
+	cleared := map[string]interface{}{}
+	if project.ClearDescription {
+		cleared["description"] = ""
+	}
+
+	if project.ClearProfileContent {
+		cleared["profile_content"] = ""
+	}
+	return cleared
 }
 
 type Person struct {
@@ -64,8 +87,45 @@ type AccountMemberRequest struct {
 	PersonID       int    `json:"person_id,omitempty"`
 	Email          string `json:"email,omitempty"`
 	Initials       string `json:"initials,omitempty"`
-	Admin          bool   `json:"admin,omitempty"`
-	ProjectCreator bool   `json:"project_creator,omitempty"`
+	Admin          *bool  `json:"admin,omitempty"`
+	ProjectCreator *bool  `json:"project_creator,omitempty"`
+}
+
+// Bool returns a pointer to the given value. Request bools are pointers so
+// that an explicit false is sent to the api while nil is left out.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// BoolValue returns the value of the given bool pointer, or false if nil.
+func BoolValue(v *bool) bool {
+	if v == nil {
+		return false
+	}
+	return *v
+}
+
+// marshalClearing marshals a request and then sets the given attributes in
+// it, so that a cleared value is sent to the api instead of being left out.
+func marshalClearing(request interface{}, cleared map[string]interface{}) ([]byte, error) {
+	body, err := json.Marshal(request)
+	if err != nil || len(cleared) == 0 {
+		return body, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+
+	for k, v := range cleared {
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		fields[k] = value
+	}
+	return json.Marshal(fields)
 }
 
 type ProjectsRequest struct {
@@ -74,6 +134,17 @@ type ProjectsRequest struct {
 	ProjectRequest
 }
 
+// MarshalJSON keeps no_owner and new_account_name in the request, which the
+// MarshalJSON of the embedded ProjectRequest would otherwise replace.
+func (projects ProjectsRequest) MarshalJSON() ([]byte, error) {
+	type projectRequest ProjectRequest
+	return marshalClearing(struct {
+		NoOwner        bool   `json:"no_owner,omitempty"`
+		NewAccountName string `json:"new_account_name,omitempty"`
+		projectRequest
+	}{projects.NoOwner, projects.NewAccountName, projectRequest(projects.ProjectRequest)}, projects.cleared())
+}
+
 //go:generate counterfeiter . RequestDoer
 type RequestDoer interface {
 	Do(req *http.Request, v interface{}) (*http.Response, error)
@@ -84,6 +155,19 @@ type RequestDoer interface {
 type ClientCaller interface {
 	ProjectCaller
 	AccountMemberCaller
+	ProjectMembershipCaller
+	LabelCaller
+	EpicCaller
+	StoryCaller
+	TaskCaller
+	WebhookCaller
+	IntegrationCaller
+	IterationOverrideCaller
+	WorkspaceCaller
+	AccountCaller
+	ReviewTypeCaller
+	ReviewCaller
+	MeCaller
 }
 
 //go:generate counterfeiter . AccountMemberCaller
@@ -104,9 +188,23 @@ type ProjectCaller interface {
 	DeleteProject(projectID int) (*http.Response, error)
 }
 
-func NewClient(apiToken string) ClientCaller {
+// Config holds the settings used to build a tracker api client.
+type Config struct {
+	APIToken          string
+	BaseURL           string
+	MaxRetries        int
+	RetryMaxWait      time.Duration
+	RequestsPerSecond float64
+}
+
+// NewClient returns a client whose requests, retries included, all share a
+// single rate limit.
+func NewClient(config Config) ClientCaller {
+	doer := NewRequestDoer(config.APIToken, config.BaseURL)
+	doer = NewRateLimitedRequestDoer(doer, config.RequestsPerSecond)
+	doer = NewRetryingRequestDoer(doer, config.MaxRetries, config.RetryMaxWait)
 	return &Client{
-		RequestDoer: pivotal.NewClient(apiToken),
+		RequestDoer: doer,
 	}
 }
 
@@ -201,7 +299,7 @@ func (service *Client) ListAccountMembers(accountID int) ([]AccountMember, *http
 	responseMembers := make([]AccountMember, 0)
 	resp, err := service.Do(req, &responseMembers)
 	if err != nil {
-		return nil, resp, fmt.Errorf("failed calling service: %v", err)
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
 	}
 
 	return responseMembers, resp, nil
@@ -217,7 +315,7 @@ func (service *Client) GetAccountMember(accountID int, accountMemberID int) (*Ac
 	responseMember := &AccountMember{}
 	resp, err := service.Do(req, responseMember)
 	if err != nil {
-		return nil, resp, fmt.Errorf("failed calling service: %v", err)
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
 	}
 
 	return responseMember, resp, nil
@@ -233,7 +331,7 @@ func (service *Client) NewAccountMember(accountID int, member AccountMemberReque
 	responseMember := &AccountMember{}
 	resp, err := service.Do(req, responseMember)
 	if err != nil {
-		return nil, resp, fmt.Errorf("failed calling service: %v", err)
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
 	}
 
 	return responseMember, resp, nil
@@ -249,7 +347,7 @@ func (service *Client) UpdateAccountMember(accountID int, accountMemberID int, m
 	responseMember := &AccountMember{}
 	resp, err := service.Do(req, responseMember)
 	if err != nil {
-		return nil, resp, fmt.Errorf("failed calling service: %v", err)
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
 	}
 
 	return responseMember, resp, nil
@@ -264,7 +362,7 @@ func (service *Client) DeleteAccountMember(accountID int, accountMemberID int) (
 
 	resp, err := service.Do(req, nil)
 	if err != nil {
-		return resp, fmt.Errorf("failed calling service: %v", err)
+		return resp, fmt.Errorf("failed calling service: %w", err)
 	}
 
 	return resp, nil
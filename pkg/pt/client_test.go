@@ -1,6 +1,7 @@
 package pt_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -66,6 +67,39 @@ func TestClient(t *testing.T) {
 		})
 	})
 
+	t.Run("Requests", func(t *testing.T) {
+		t.Run("should send explicit false bools and leave out unset ones", func(t *testing.T) {
+			body, err := json.Marshal(pt.ProjectRequest{Public: pt.Bool(false)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal(`{"public":false}`))
+
+			body, err = json.Marshal(pt.AccountMemberRequest{Admin: pt.Bool(false)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal(`{"admin":false}`))
+
+			body, err = json.Marshal(pt.ProjectRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal(`{}`))
+		})
+
+		t.Run("should send the cleared attributes", func(t *testing.T) {
+			body, err := json.Marshal(pt.ProjectRequest{Name: "some project", ClearDescription: true, ClearProfileContent: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(MatchJSON(`{"name":"some project","description":"","profile_content":""}`))
+		})
+
+		t.Run("should keep the create only attributes of a new project", func(t *testing.T) {
+			body, err := json.Marshal(pt.ProjectsRequest{NoOwner: true, NewAccountName: "some account", ProjectRequest: pt.ProjectRequest{Name: "some project"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(MatchJSON(`{"no_owner":true,"new_account_name":"some account","name":"some project"}`))
+		})
+
+		t.Run("BoolValue should treat nil as false", func(t *testing.T) {
+			Expect(pt.BoolValue(nil)).To(BeFalse())
+			Expect(pt.BoolValue(pt.Bool(true))).To(BeTrue())
+		})
+	})
+
 	t.Run("ProjectCaller", func(t *testing.T) {
 		t.Run("API Call Structure", func(t *testing.T) {
 			client := &pt.Client{}
@@ -0,0 +1,127 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Epic struct {
+	Kind        string `json:"kind,omitempty"`
+	ID          int    `json:"id,omitempty"`
+	ProjectID   int    `json:"project_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Label       Label  `json:"label,omitempty"`
+}
+
+// EpicRequest links the epic to the label with the given name, creating
+// that label when the project does not have it yet. Setting
+// ClearDescription sends an empty description.
+type EpicRequest struct {
+	Name             string        `json:"name,omitempty"`
+	Description      string        `json:"description,omitempty"`
+	Label            *LabelRequest `json:"label,omitempty"`
+	BeforeID         int           `json:"before_id,omitempty"`
+	AfterID          int           `json:"after_id,omitempty"`
+	ClearDescription bool          `json:"-"`
+}
+
+// MarshalJSON sends an empty description when it is cleared, which
+// omitempty would otherwise leave out.
+func (epic EpicRequest) MarshalJSON() ([]byte, error) {
+	type epicRequest EpicRequest
+	cleared := map[string]interface{}{}
+	if epic.ClearDescription {
+		cleared["description"] = ""
+	}
+	return marshalClearing(epicRequest(epic), cleared)
+}
+
+//go:generate counterfeiter . EpicCaller
+type EpicCaller interface {
+	ListEpics(projectID int) ([]Epic, *http.Response, error)
+	GetEpic(projectID int, epicID int) (*Epic, *http.Response, error)
+	NewEpic(projectID int, epic EpicRequest) (*Epic, *http.Response, error)
+	UpdateEpic(projectID int, epicID int, epic EpicRequest) (*Epic, *http.Response, error)
+	DeleteEpic(projectID int, epicID int) (*http.Response, error)
+}
+
+// ListEpics - list all epics of a project
+func (service *Client) ListEpics(projectID int) ([]Epic, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/epics", projectID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseEpics := make([]Epic, 0)
+	resp, err := service.Do(req, &responseEpics)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseEpics, resp, nil
+}
+
+// GetEpic - retrieve an epic's details from the api
+func (service *Client) GetEpic(projectID int, epicID int) (*Epic, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/epics/%v", projectID, epicID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseEpic := &Epic{}
+	resp, err := service.Do(req, responseEpic)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseEpic, resp, nil
+}
+
+// NewEpic - creates an epic in the given project
+func (service *Client) NewEpic(projectID int, epic EpicRequest) (*Epic, *http.Response, error) {
+	req, err := service.NewRequest("POST", fmt.Sprintf("projects/%v/epics", projectID), epic)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseEpic := &Epic{}
+	resp, err := service.Do(req, responseEpic)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseEpic, resp, nil
+}
+
+// UpdateEpic - updates the name, description or position of a given epic.
+func (service *Client) UpdateEpic(projectID int, epicID int, epic EpicRequest) (*Epic, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/epics/%v", projectID, epicID), epic)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseEpic := &Epic{}
+	resp, err := service.Do(req, responseEpic)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseEpic, resp, nil
+}
+
+// DeleteEpic removes an epic from a project by epic id.
+func (service *Client) DeleteEpic(projectID int, epicID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("projects/%v/epics/%v", projectID, epicID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,81 @@
+package pt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestEpicClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Requests", func(t *testing.T) {
+		t.Run("should send an emptied description", func(t *testing.T) {
+			body, err := json.Marshal(pt.EpicRequest{Name: "some epic", ClearDescription: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(MatchJSON(`{"name":"some epic","description":""}`))
+
+			body, err = json.Marshal(pt.EpicRequest{Name: "some epic"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal(`{"name":"some epic"}`))
+		})
+	})
+
+	t.Run("EpicCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlProjectID := 1234
+			controlEpicID := 5678
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteEpic", fmt.Sprintf("projects/%v/epics/%v", controlProjectID, controlEpicID), "DELETE", false, func() {
+					client.DeleteEpic(controlProjectID, controlEpicID)
+				}},
+				{"UpdateEpic", fmt.Sprintf("projects/%v/epics/%v", controlProjectID, controlEpicID), "PUT", true, func() {
+					client.UpdateEpic(controlProjectID, controlEpicID, pt.EpicRequest{})
+				}},
+				{"NewEpic", fmt.Sprintf("projects/%v/epics", controlProjectID), "POST", true, func() {
+					client.NewEpic(controlProjectID, pt.EpicRequest{})
+				}},
+				{"ListEpics", fmt.Sprintf("projects/%v/epics", controlProjectID), "GET", false, func() {
+					client.ListEpics(controlProjectID)
+				}},
+				{"GetEpic", fmt.Sprintf("projects/%v/epics/%v", controlProjectID, controlEpicID), "GET", false, func() {
+					client.GetEpic(controlProjectID, controlEpicID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,75 @@
+package pt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Error is the error envelope the tracker api returns with any non 2xx
+// response, along with the http status code of that response. Callers can
+// use errors.As to get at it through wrapped errors.
+type Error struct {
+	StatusCode       int               `json:"-"`
+	Code             string            `json:"code"`
+	Kind             string            `json:"kind"`
+	Message          string            `json:"error"`
+	Requirement      string            `json:"requirement"`
+	GeneralProblem   string            `json:"general_problem"`
+	PossibleFix      string            `json:"possible_fix"`
+	ValidationErrors []ValidationError `json:"validation_errors"`
+}
+
+// ValidationError describes the problem tracker found with a single field
+// of the request.
+type ValidationError struct {
+	Field   string `json:"field"`
+	Problem string `json:"problem"`
+}
+
+func (e ValidationError) String() string {
+	return fmt.Sprintf("%v: %v", e.Field, e.Problem)
+}
+
+func (e *Error) Error() string {
+	details := []string{fmt.Sprintf("tracker api responded %v: %v (%v)", e.StatusCode, e.Message, e.Code)}
+	for _, validationError := range e.ValidationErrors {
+		details = append(details, validationError.String())
+	}
+
+	if e.GeneralProblem != "" {
+		details = append(details, e.GeneralProblem)
+	}
+
+	if e.Requirement != "" {
+		details = append(details, e.Requirement)
+	}
+
+	if e.PossibleFix != "" {
+		details = append(details, e.PossibleFix)
+	}
+	return strings.Join(details, "; ")
+}
+
+// IsNotFound reports whether err is, or wraps, a 404 from the tracker api.
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+func newError(resp *http.Response) *Error {
+	apiErr := &Error{}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil || json.Unmarshal(body, apiErr) != nil {
+		apiErr = &Error{}
+	}
+
+	apiErr.StatusCode = resp.StatusCode
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}
@@ -0,0 +1,121 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Integration struct {
+	IntegrationRequest
+	Kind      string `json:"kind,omitempty"`
+	ID        int    `json:"id,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+}
+
+// IntegrationRequest holds the fields of every kind of integration, each
+// kind only uses some of them. Tracker never returns the passwords.
+type IntegrationRequest struct {
+	Type                string `json:"type,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Active              *bool  `json:"active,omitempty"`
+	BaseURL             string `json:"base_url,omitempty"`
+	ImportAPIURL        string `json:"import_api_url,omitempty"`
+	CanImport           *bool  `json:"can_import,omitempty"`
+	CommentsPrivate     *bool  `json:"comments_private,omitempty"`
+	UpdateComments      *bool  `json:"update_comments,omitempty"`
+	APIUsername         string `json:"api_username,omitempty"`
+	APIPassword         string `json:"api_password,omitempty"`
+	FilterID            string `json:"filter_id,omitempty"`
+	Product             string `json:"product,omitempty"`
+	Component           string `json:"component,omitempty"`
+	ZendeskUserEmail    string `json:"zendesk_user_email,omitempty"`
+	ZendeskUserPassword string `json:"zendesk_user_password,omitempty"`
+}
+
+//go:generate counterfeiter . IntegrationCaller
+type IntegrationCaller interface {
+	ListIntegrations(projectID int) ([]Integration, *http.Response, error)
+	GetIntegration(projectID int, integrationID int) (*Integration, *http.Response, error)
+	NewIntegration(projectID int, integration IntegrationRequest) (*Integration, *http.Response, error)
+	UpdateIntegration(projectID int, integrationID int, integration IntegrationRequest) (*Integration, *http.Response, error)
+	DeleteIntegration(projectID int, integrationID int) (*http.Response, error)
+}
+
+// ListIntegrations - list all integrations of a project
+func (service *Client) ListIntegrations(projectID int) ([]Integration, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/integrations", projectID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseIntegrations := make([]Integration, 0)
+	resp, err := service.Do(req, &responseIntegrations)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseIntegrations, resp, nil
+}
+
+// GetIntegration - retrieve an integration's details from the api
+func (service *Client) GetIntegration(projectID int, integrationID int) (*Integration, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/integrations/%v", projectID, integrationID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseIntegration := &Integration{}
+	resp, err := service.Do(req, responseIntegration)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseIntegration, resp, nil
+}
+
+// NewIntegration - adds an integration to the given project
+func (service *Client) NewIntegration(projectID int, integration IntegrationRequest) (*Integration, *http.Response, error) {
+	req, err := service.NewRequest("POST", fmt.Sprintf("projects/%v/integrations", projectID), integration)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseIntegration := &Integration{}
+	resp, err := service.Do(req, responseIntegration)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseIntegration, resp, nil
+}
+
+// UpdateIntegration - updates the settings of a given integration.
+func (service *Client) UpdateIntegration(projectID int, integrationID int, integration IntegrationRequest) (*Integration, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/integrations/%v", projectID, integrationID), integration)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseIntegration := &Integration{}
+	resp, err := service.Do(req, responseIntegration)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseIntegration, resp, nil
+}
+
+// DeleteIntegration removes an integration from a project by integration id.
+func (service *Client) DeleteIntegration(projectID int, integrationID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("projects/%v/integrations/%v", projectID, integrationID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
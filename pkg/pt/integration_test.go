@@ -0,0 +1,68 @@
+package pt_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestIntegrationClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("IntegrationCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlProjectID := 1234
+			controlIntegrationID := 5678
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteIntegration", fmt.Sprintf("projects/%v/integrations/%v", controlProjectID, controlIntegrationID), "DELETE", false, func() {
+					client.DeleteIntegration(controlProjectID, controlIntegrationID)
+				}},
+				{"UpdateIntegration", fmt.Sprintf("projects/%v/integrations/%v", controlProjectID, controlIntegrationID), "PUT", true, func() {
+					client.UpdateIntegration(controlProjectID, controlIntegrationID, pt.IntegrationRequest{})
+				}},
+				{"NewIntegration", fmt.Sprintf("projects/%v/integrations", controlProjectID), "POST", true, func() {
+					client.NewIntegration(controlProjectID, pt.IntegrationRequest{})
+				}},
+				{"ListIntegrations", fmt.Sprintf("projects/%v/integrations", controlProjectID), "GET", false, func() {
+					client.ListIntegrations(controlProjectID)
+				}},
+				{"GetIntegration", fmt.Sprintf("projects/%v/integrations/%v", controlProjectID, controlIntegrationID), "GET", false, func() {
+					client.GetIntegration(controlProjectID, controlIntegrationID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
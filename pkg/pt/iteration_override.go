@@ -0,0 +1,87 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type IterationOverride struct {
+	IterationOverrideRequest
+	Kind      string `json:"kind,omitempty"`
+	Number    int    `json:"number,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+}
+
+// Iteration is an iteration of a project, as returned by the iterations
+// endpoints.
+type Iteration struct {
+	Kind         string  `json:"kind,omitempty"`
+	Number       int     `json:"number,omitempty"`
+	ProjectID    int     `json:"project_id,omitempty"`
+	Length       int     `json:"length,omitempty"`
+	TeamStrength float64 `json:"team_strength,omitempty"`
+}
+
+type IterationOverrideRequest struct {
+	Length       *int     `json:"length,omitempty"`
+	TeamStrength *float64 `json:"team_strength,omitempty"`
+}
+
+//go:generate counterfeiter . IterationOverrideCaller
+type IterationOverrideCaller interface {
+	ListIterationOverrides(projectID int) ([]IterationOverride, *http.Response, error)
+	UpdateIterationOverride(projectID int, iterationNumber int, override IterationOverrideRequest) (*IterationOverride, *http.Response, error)
+	GetIteration(projectID int, iterationNumber int) (*Iteration, *http.Response, error)
+}
+
+// ListIterationOverrides - list the iterations of a project whose length or
+// team strength differ from the project defaults
+func (service *Client) ListIterationOverrides(projectID int) ([]IterationOverride, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/iteration_overrides", projectID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseOverrides := make([]IterationOverride, 0)
+	resp, err := service.Do(req, &responseOverrides)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseOverrides, resp, nil
+}
+
+// UpdateIterationOverride - sets the length or team strength of a given
+// iteration. Tracker has no create or delete for overrides, an iteration
+// without one follows the project defaults.
+func (service *Client) UpdateIterationOverride(projectID int, iterationNumber int, override IterationOverrideRequest) (*IterationOverride, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/iteration_overrides/%v", projectID, iterationNumber), override)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseOverride := &IterationOverride{}
+	resp, err := service.Do(req, responseOverride)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseOverride, resp, nil
+}
+
+// GetIteration - retrieve an iteration's details from the api, tracker
+// responds with a 404 for an iteration the project does not have
+func (service *Client) GetIteration(projectID int, iterationNumber int) (*Iteration, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/iterations/%v", projectID, iterationNumber), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseIteration := &Iteration{}
+	resp, err := service.Do(req, responseIteration)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseIteration, resp, nil
+}
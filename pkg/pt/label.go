@@ -0,0 +1,105 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Label struct {
+	LabelRequest
+	Kind      string `json:"kind,omitempty"`
+	ID        int    `json:"id,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+}
+
+type LabelRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+//go:generate counterfeiter . LabelCaller
+type LabelCaller interface {
+	ListLabels(projectID int) ([]Label, *http.Response, error)
+	GetLabel(projectID int, labelID int) (*Label, *http.Response, error)
+	NewLabel(projectID int, label LabelRequest) (*Label, *http.Response, error)
+	UpdateLabel(projectID int, labelID int, label LabelRequest) (*Label, *http.Response, error)
+	DeleteLabel(projectID int, labelID int) (*http.Response, error)
+}
+
+// ListLabels - list all labels of a project
+func (service *Client) ListLabels(projectID int) ([]Label, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/labels", projectID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseLabels := make([]Label, 0)
+	resp, err := service.Do(req, &responseLabels)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseLabels, resp, nil
+}
+
+// GetLabel - retrieve a label's details from the api
+func (service *Client) GetLabel(projectID int, labelID int) (*Label, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/labels/%v", projectID, labelID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseLabel := &Label{}
+	resp, err := service.Do(req, responseLabel)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseLabel, resp, nil
+}
+
+// NewLabel - creates a label in the given project
+func (service *Client) NewLabel(projectID int, label LabelRequest) (*Label, *http.Response, error) {
+	req, err := service.NewRequest("POST", fmt.Sprintf("projects/%v/labels", projectID), label)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseLabel := &Label{}
+	resp, err := service.Do(req, responseLabel)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseLabel, resp, nil
+}
+
+// UpdateLabel - renames a given label.
+func (service *Client) UpdateLabel(projectID int, labelID int, label LabelRequest) (*Label, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/labels/%v", projectID, labelID), label)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseLabel := &Label{}
+	resp, err := service.Do(req, responseLabel)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseLabel, resp, nil
+}
+
+// DeleteLabel removes a label from a project by label id.
+func (service *Client) DeleteLabel(projectID int, labelID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("projects/%v/labels/%v", projectID, labelID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
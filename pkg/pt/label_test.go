@@ -0,0 +1,68 @@
+package pt_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestLabelClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("LabelCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlProjectID := 1234
+			controlLabelID := 5678
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteLabel", fmt.Sprintf("projects/%v/labels/%v", controlProjectID, controlLabelID), "DELETE", false, func() {
+					client.DeleteLabel(controlProjectID, controlLabelID)
+				}},
+				{"UpdateLabel", fmt.Sprintf("projects/%v/labels/%v", controlProjectID, controlLabelID), "PUT", true, func() {
+					client.UpdateLabel(controlProjectID, controlLabelID, pt.LabelRequest{})
+				}},
+				{"NewLabel", fmt.Sprintf("projects/%v/labels", controlProjectID), "POST", true, func() {
+					client.NewLabel(controlProjectID, pt.LabelRequest{})
+				}},
+				{"ListLabels", fmt.Sprintf("projects/%v/labels", controlProjectID), "GET", false, func() {
+					client.ListLabels(controlProjectID)
+				}},
+				{"GetLabel", fmt.Sprintf("projects/%v/labels/%v", controlProjectID, controlLabelID), "GET", false, func() {
+					client.GetLabel(controlProjectID, controlLabelID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
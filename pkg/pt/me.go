@@ -0,0 +1,52 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Me is the person owning the api token, along with a summary of their
+// accounts and project memberships.
+type Me struct {
+	Person
+	Accounts []AccountSummary    `json:"accounts,omitempty"`
+	Projects []MembershipSummary `json:"projects,omitempty"`
+}
+
+type AccountSummary struct {
+	Kind   string `json:"kind,omitempty"`
+	ID     int    `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+	Plan   string `json:"plan,omitempty"`
+}
+
+type MembershipSummary struct {
+	Kind        string `json:"kind,omitempty"`
+	ID          int    `json:"id,omitempty"`
+	ProjectID   int    `json:"project_id,omitempty"`
+	ProjectName string `json:"project_name,omitempty"`
+	Role        string `json:"role,omitempty"`
+	Favorite    bool   `json:"favorite,omitempty"`
+}
+
+//go:generate counterfeiter . MeCaller
+type MeCaller interface {
+	GetMe() (*Me, *http.Response, error)
+}
+
+// GetMe - retrieve the details of the person owning the api token
+func (service *Client) GetMe() (*Me, *http.Response, error) {
+	req, err := service.NewRequest("GET", "me", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseMe := &Me{}
+	resp, err := service.Do(req, responseMe)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseMe, resp, nil
+}
@@ -0,0 +1,111 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ProjectMembershipResponse is a project membership as returned by the
+// memberships endpoints.
+type ProjectMembershipResponse struct {
+	ProjectMembershipRequest
+	Kind      string `json:"kind,omitempty"`
+	ID        int    `json:"id,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+	Person    Person `json:"person,omitempty"`
+}
+
+type ProjectMembershipRequest struct {
+	PersonID     int    `json:"person_id,omitempty"`
+	Email        string `json:"email,omitempty"`
+	Role         string `json:"role,omitempty"`
+	ProjectColor string `json:"project_color,omitempty"`
+}
+
+//go:generate counterfeiter . ProjectMembershipCaller
+type ProjectMembershipCaller interface {
+	ListProjectMemberships(projectID int) ([]ProjectMembershipResponse, *http.Response, error)
+	GetProjectMembership(projectID int, membershipID int) (*ProjectMembershipResponse, *http.Response, error)
+	NewProjectMembership(projectID int, membership ProjectMembershipRequest) (*ProjectMembershipResponse, *http.Response, error)
+	UpdateProjectMembership(projectID int, membershipID int, membership ProjectMembershipRequest) (*ProjectMembershipResponse, *http.Response, error)
+	DeleteProjectMembership(projectID int, membershipID int) (*http.Response, error)
+}
+
+// ListProjectMemberships - list all memberships of a project
+func (service *Client) ListProjectMemberships(projectID int) ([]ProjectMembershipResponse, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/memberships", projectID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseMemberships := make([]ProjectMembershipResponse, 0)
+	resp, err := service.Do(req, &responseMemberships)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseMemberships, resp, nil
+}
+
+// GetProjectMembership - retrieve a project membership's details from the api
+func (service *Client) GetProjectMembership(projectID int, membershipID int) (*ProjectMembershipResponse, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/memberships/%v", projectID, membershipID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseMembership := &ProjectMembershipResponse{}
+	resp, err := service.Do(req, responseMembership)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseMembership, resp, nil
+}
+
+// NewProjectMembership - adds a person to a project with the given role
+func (service *Client) NewProjectMembership(projectID int, membership ProjectMembershipRequest) (*ProjectMembershipResponse, *http.Response, error) {
+	req, err := service.NewRequest("POST", fmt.Sprintf("projects/%v/memberships", projectID), membership)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseMembership := &ProjectMembershipResponse{}
+	resp, err := service.Do(req, responseMembership)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseMembership, resp, nil
+}
+
+// UpdateProjectMembership - updates the role or color of a given project membership.
+func (service *Client) UpdateProjectMembership(projectID int, membershipID int, membership ProjectMembershipRequest) (*ProjectMembershipResponse, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/memberships/%v", projectID, membershipID), membership)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseMembership := &ProjectMembershipResponse{}
+	resp, err := service.Do(req, responseMembership)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseMembership, resp, nil
+}
+
+// DeleteProjectMembership removes a person from a project by membership id.
+func (service *Client) DeleteProjectMembership(projectID int, membershipID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("projects/%v/memberships/%v", projectID, membershipID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,68 @@
+package pt_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestProjectMembershipClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("ProjectMembershipCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlProjectID := 1234
+			controlMembershipID := 5678
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteProjectMembership", fmt.Sprintf("projects/%v/memberships/%v", controlProjectID, controlMembershipID), "DELETE", false, func() {
+					client.DeleteProjectMembership(controlProjectID, controlMembershipID)
+				}},
+				{"UpdateProjectMembership", fmt.Sprintf("projects/%v/memberships/%v", controlProjectID, controlMembershipID), "PUT", true, func() {
+					client.UpdateProjectMembership(controlProjectID, controlMembershipID, pt.ProjectMembershipRequest{})
+				}},
+				{"NewProjectMembership", fmt.Sprintf("projects/%v/memberships", controlProjectID), "POST", true, func() {
+					client.NewProjectMembership(controlProjectID, pt.ProjectMembershipRequest{})
+				}},
+				{"ListProjectMemberships", fmt.Sprintf("projects/%v/memberships", controlProjectID), "GET", false, func() {
+					client.ListProjectMemberships(controlProjectID)
+				}},
+				{"GetProjectMembership", fmt.Sprintf("projects/%v/memberships/%v", controlProjectID, controlMembershipID), "GET", false, func() {
+					client.GetProjectMembership(controlProjectID, controlMembershipID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
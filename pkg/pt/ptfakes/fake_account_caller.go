@@ -0,0 +1,280 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeAccountCaller struct {
+	GetAccountStub        func(int) (*pt.Account, *http.Response, error)
+	getAccountMutex       sync.RWMutex
+	getAccountArgsForCall []struct {
+		arg1 int
+	}
+	getAccountReturns struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}
+	getAccountReturnsOnCall map[int]struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}
+	ListAccountsStub        func() ([]pt.Account, *http.Response, error)
+	listAccountsMutex       sync.RWMutex
+	listAccountsArgsForCall []struct {
+	}
+	listAccountsReturns struct {
+		result1 []pt.Account
+		result2 *http.Response
+		result3 error
+	}
+	listAccountsReturnsOnCall map[int]struct {
+		result1 []pt.Account
+		result2 *http.Response
+		result3 error
+	}
+	UpdateAccountStub        func(int, pt.AccountRequest) (*pt.Account, *http.Response, error)
+	updateAccountMutex       sync.RWMutex
+	updateAccountArgsForCall []struct {
+		arg1 int
+		arg2 pt.AccountRequest
+	}
+	updateAccountReturns struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}
+	updateAccountReturnsOnCall map[int]struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAccountCaller) GetAccount(arg1 int) (*pt.Account, *http.Response, error) {
+	fake.getAccountMutex.Lock()
+	ret, specificReturn := fake.getAccountReturnsOnCall[len(fake.getAccountArgsForCall)]
+	fake.getAccountArgsForCall = append(fake.getAccountArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("GetAccount", []interface{}{arg1})
+	fake.getAccountMutex.Unlock()
+	if fake.GetAccountStub != nil {
+		return fake.GetAccountStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAccountReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAccountCaller) GetAccountCallCount() int {
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	return len(fake.getAccountArgsForCall)
+}
+
+func (fake *FakeAccountCaller) GetAccountCalls(stub func(int) (*pt.Account, *http.Response, error)) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = stub
+}
+
+func (fake *FakeAccountCaller) GetAccountArgsForCall(i int) int {
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	argsForCall := fake.getAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccountCaller) GetAccountReturns(result1 *pt.Account, result2 *http.Response, result3 error) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = nil
+	fake.getAccountReturns = struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAccountCaller) GetAccountReturnsOnCall(i int, result1 *pt.Account, result2 *http.Response, result3 error) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = nil
+	if fake.getAccountReturnsOnCall == nil {
+		fake.getAccountReturnsOnCall = make(map[int]struct {
+			result1 *pt.Account
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getAccountReturnsOnCall[i] = struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAccountCaller) ListAccounts() ([]pt.Account, *http.Response, error) {
+	fake.listAccountsMutex.Lock()
+	ret, specificReturn := fake.listAccountsReturnsOnCall[len(fake.listAccountsArgsForCall)]
+	fake.listAccountsArgsForCall = append(fake.listAccountsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListAccounts", []interface{}{})
+	fake.listAccountsMutex.Unlock()
+	if fake.ListAccountsStub != nil {
+		return fake.ListAccountsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listAccountsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAccountCaller) ListAccountsCallCount() int {
+	fake.listAccountsMutex.RLock()
+	defer fake.listAccountsMutex.RUnlock()
+	return len(fake.listAccountsArgsForCall)
+}
+
+func (fake *FakeAccountCaller) ListAccountsCalls(stub func() ([]pt.Account, *http.Response, error)) {
+	fake.listAccountsMutex.Lock()
+	defer fake.listAccountsMutex.Unlock()
+	fake.ListAccountsStub = stub
+}
+
+func (fake *FakeAccountCaller) ListAccountsReturns(result1 []pt.Account, result2 *http.Response, result3 error) {
+	fake.listAccountsMutex.Lock()
+	defer fake.listAccountsMutex.Unlock()
+	fake.ListAccountsStub = nil
+	fake.listAccountsReturns = struct {
+		result1 []pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAccountCaller) ListAccountsReturnsOnCall(i int, result1 []pt.Account, result2 *http.Response, result3 error) {
+	fake.listAccountsMutex.Lock()
+	defer fake.listAccountsMutex.Unlock()
+	fake.ListAccountsStub = nil
+	if fake.listAccountsReturnsOnCall == nil {
+		fake.listAccountsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Account
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listAccountsReturnsOnCall[i] = struct {
+		result1 []pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAccountCaller) UpdateAccount(arg1 int, arg2 pt.AccountRequest) (*pt.Account, *http.Response, error) {
+	fake.updateAccountMutex.Lock()
+	ret, specificReturn := fake.updateAccountReturnsOnCall[len(fake.updateAccountArgsForCall)]
+	fake.updateAccountArgsForCall = append(fake.updateAccountArgsForCall, struct {
+		arg1 int
+		arg2 pt.AccountRequest
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateAccount", []interface{}{arg1, arg2})
+	fake.updateAccountMutex.Unlock()
+	if fake.UpdateAccountStub != nil {
+		return fake.UpdateAccountStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateAccountReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAccountCaller) UpdateAccountCallCount() int {
+	fake.updateAccountMutex.RLock()
+	defer fake.updateAccountMutex.RUnlock()
+	return len(fake.updateAccountArgsForCall)
+}
+
+func (fake *FakeAccountCaller) UpdateAccountCalls(stub func(int, pt.AccountRequest) (*pt.Account, *http.Response, error)) {
+	fake.updateAccountMutex.Lock()
+	defer fake.updateAccountMutex.Unlock()
+	fake.UpdateAccountStub = stub
+}
+
+func (fake *FakeAccountCaller) UpdateAccountArgsForCall(i int) (int, pt.AccountRequest) {
+	fake.updateAccountMutex.RLock()
+	defer fake.updateAccountMutex.RUnlock()
+	argsForCall := fake.updateAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccountCaller) UpdateAccountReturns(result1 *pt.Account, result2 *http.Response, result3 error) {
+	fake.updateAccountMutex.Lock()
+	defer fake.updateAccountMutex.Unlock()
+	fake.UpdateAccountStub = nil
+	fake.updateAccountReturns = struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAccountCaller) UpdateAccountReturnsOnCall(i int, result1 *pt.Account, result2 *http.Response, result3 error) {
+	fake.updateAccountMutex.Lock()
+	defer fake.updateAccountMutex.Unlock()
+	fake.UpdateAccountStub = nil
+	if fake.updateAccountReturnsOnCall == nil {
+		fake.updateAccountReturnsOnCall = make(map[int]struct {
+			result1 *pt.Account
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateAccountReturnsOnCall[i] = struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAccountCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	fake.listAccountsMutex.RLock()
+	defer fake.listAccountsMutex.RUnlock()
+	fake.updateAccountMutex.RLock()
+	defer fake.updateAccountMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAccountCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.AccountCaller = new(FakeAccountCaller)
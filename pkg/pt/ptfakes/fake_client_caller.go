@@ -23,6 +23,48 @@ type FakeClientCaller struct {
 		result1 *http.Response
 		result2 error
 	}
+	DeleteEpicStub        func(int, int) (*http.Response, error)
+	deleteEpicMutex       sync.RWMutex
+	deleteEpicArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteEpicReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteEpicReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	DeleteIntegrationStub        func(int, int) (*http.Response, error)
+	deleteIntegrationMutex       sync.RWMutex
+	deleteIntegrationArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteIntegrationReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteIntegrationReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	DeleteLabelStub        func(int, int) (*http.Response, error)
+	deleteLabelMutex       sync.RWMutex
+	deleteLabelArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteLabelReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteLabelReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
 	DeleteProjectStub        func(int) (*http.Response, error)
 	deleteProjectMutex       sync.RWMutex
 	deleteProjectArgsForCall []struct {
@@ -36,6 +78,120 @@ type FakeClientCaller struct {
 		result1 *http.Response
 		result2 error
 	}
+	DeleteProjectMembershipStub        func(int, int) (*http.Response, error)
+	deleteProjectMembershipMutex       sync.RWMutex
+	deleteProjectMembershipArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteProjectMembershipReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteProjectMembershipReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	DeleteReviewStub        func(int, int, int) (*http.Response, error)
+	deleteReviewMutex       sync.RWMutex
+	deleteReviewArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}
+	deleteReviewReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteReviewReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	DeleteReviewTypeStub        func(int, int) (*http.Response, error)
+	deleteReviewTypeMutex       sync.RWMutex
+	deleteReviewTypeArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteReviewTypeReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteReviewTypeReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	DeleteStoryStub        func(int, int) (*http.Response, error)
+	deleteStoryMutex       sync.RWMutex
+	deleteStoryArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteStoryReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteStoryReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	DeleteTaskStub        func(int, int, int) (*http.Response, error)
+	deleteTaskMutex       sync.RWMutex
+	deleteTaskArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}
+	deleteTaskReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteTaskReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	DeleteWebhookStub        func(int, int) (*http.Response, error)
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteWebhookReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteWebhookReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	DeleteWorkspaceStub        func(int) (*http.Response, error)
+	deleteWorkspaceMutex       sync.RWMutex
+	deleteWorkspaceArgsForCall []struct {
+		arg1 int
+	}
+	deleteWorkspaceReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteWorkspaceReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetAccountStub        func(int) (*pt.Account, *http.Response, error)
+	getAccountMutex       sync.RWMutex
+	getAccountArgsForCall []struct {
+		arg1 int
+	}
+	getAccountReturns struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}
+	getAccountReturnsOnCall map[int]struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}
 	GetAccountMemberStub        func(int, int) (*pt.AccountMember, *http.Response, error)
 	getAccountMemberMutex       sync.RWMutex
 	getAccountMemberArgsForCall []struct {
@@ -52,6 +208,84 @@ type FakeClientCaller struct {
 		result2 *http.Response
 		result3 error
 	}
+	GetEpicStub        func(int, int) (*pt.Epic, *http.Response, error)
+	getEpicMutex       sync.RWMutex
+	getEpicArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getEpicReturns struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	getEpicReturnsOnCall map[int]struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	GetIntegrationStub        func(int, int) (*pt.Integration, *http.Response, error)
+	getIntegrationMutex       sync.RWMutex
+	getIntegrationArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getIntegrationReturns struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	getIntegrationReturnsOnCall map[int]struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	GetIterationStub        func(int, int) (*pt.Iteration, *http.Response, error)
+	getIterationMutex       sync.RWMutex
+	getIterationArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getIterationReturns struct {
+		result1 *pt.Iteration
+		result2 *http.Response
+		result3 error
+	}
+	getIterationReturnsOnCall map[int]struct {
+		result1 *pt.Iteration
+		result2 *http.Response
+		result3 error
+	}
+	GetLabelStub        func(int, int) (*pt.Label, *http.Response, error)
+	getLabelMutex       sync.RWMutex
+	getLabelArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getLabelReturns struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	getLabelReturnsOnCall map[int]struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	GetMeStub        func() (*pt.Me, *http.Response, error)
+	getMeMutex       sync.RWMutex
+	getMeArgsForCall []struct {
+	}
+	getMeReturns struct {
+		result1 *pt.Me
+		result2 *http.Response
+		result3 error
+	}
+	getMeReturnsOnCall map[int]struct {
+		result1 *pt.Me
+		result2 *http.Response
+		result3 error
+	}
 	GetProjectStub        func(int) (*pt.Project, *http.Response, error)
 	getProjectMutex       sync.RWMutex
 	getProjectArgsForCall []struct {
@@ -67,6 +301,119 @@ type FakeClientCaller struct {
 		result2 *http.Response
 		result3 error
 	}
+	GetProjectMembershipStub        func(int, int) (*pt.ProjectMembershipResponse, *http.Response, error)
+	getProjectMembershipMutex       sync.RWMutex
+	getProjectMembershipArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getProjectMembershipReturns struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	getProjectMembershipReturnsOnCall map[int]struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	GetReviewStub        func(int, int, int) (*pt.Review, *http.Response, error)
+	getReviewMutex       sync.RWMutex
+	getReviewArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}
+	getReviewReturns struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	getReviewReturnsOnCall map[int]struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	GetReviewTypeStub        func(int, int) (*pt.ReviewType, *http.Response, error)
+	getReviewTypeMutex       sync.RWMutex
+	getReviewTypeArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getReviewTypeReturns struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	getReviewTypeReturnsOnCall map[int]struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	GetStoryStub        func(int, int) (*pt.Story, *http.Response, error)
+	getStoryMutex       sync.RWMutex
+	getStoryArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getStoryReturns struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	getStoryReturnsOnCall map[int]struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	GetTaskStub        func(int, int, int) (*pt.Task, *http.Response, error)
+	getTaskMutex       sync.RWMutex
+	getTaskArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}
+	getTaskReturns struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	getTaskReturnsOnCall map[int]struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	GetWebhookStub        func(int, int) (*pt.Webhook, *http.Response, error)
+	getWebhookMutex       sync.RWMutex
+	getWebhookArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getWebhookReturns struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	getWebhookReturnsOnCall map[int]struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	GetWorkspaceStub        func(int) (*pt.Workspace, *http.Response, error)
+	getWorkspaceMutex       sync.RWMutex
+	getWorkspaceArgsForCall []struct {
+		arg1 int
+	}
+	getWorkspaceReturns struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	getWorkspaceReturnsOnCall map[int]struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
 	ListAccountMembersStub        func(int) ([]pt.AccountMember, *http.Response, error)
 	listAccountMembersMutex       sync.RWMutex
 	listAccountMembersArgsForCall []struct {
@@ -82,735 +429,5048 @@ type FakeClientCaller struct {
 		result2 *http.Response
 		result3 error
 	}
-	ListProjectsStub        func() ([]*pt.Project, *http.Response, error)
-	listProjectsMutex       sync.RWMutex
-	listProjectsArgsForCall []struct {
+	ListAccountsStub        func() ([]pt.Account, *http.Response, error)
+	listAccountsMutex       sync.RWMutex
+	listAccountsArgsForCall []struct {
 	}
-	listProjectsReturns struct {
-		result1 []*pt.Project
+	listAccountsReturns struct {
+		result1 []pt.Account
 		result2 *http.Response
 		result3 error
 	}
-	listProjectsReturnsOnCall map[int]struct {
-		result1 []*pt.Project
+	listAccountsReturnsOnCall map[int]struct {
+		result1 []pt.Account
 		result2 *http.Response
 		result3 error
 	}
-	NewAccountMemberStub        func(int, pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error)
-	newAccountMemberMutex       sync.RWMutex
-	newAccountMemberArgsForCall []struct {
+	ListEpicsStub        func(int) ([]pt.Epic, *http.Response, error)
+	listEpicsMutex       sync.RWMutex
+	listEpicsArgsForCall []struct {
 		arg1 int
-		arg2 pt.AccountMemberRequest
 	}
-	newAccountMemberReturns struct {
-		result1 *pt.AccountMember
+	listEpicsReturns struct {
+		result1 []pt.Epic
 		result2 *http.Response
 		result3 error
 	}
-	newAccountMemberReturnsOnCall map[int]struct {
-		result1 *pt.AccountMember
+	listEpicsReturnsOnCall map[int]struct {
+		result1 []pt.Epic
 		result2 *http.Response
 		result3 error
 	}
-	NewProjectStub        func(pt.ProjectsRequest) (*pt.Project, *http.Response, error)
-	newProjectMutex       sync.RWMutex
-	newProjectArgsForCall []struct {
-		arg1 pt.ProjectsRequest
+	ListIntegrationsStub        func(int) ([]pt.Integration, *http.Response, error)
+	listIntegrationsMutex       sync.RWMutex
+	listIntegrationsArgsForCall []struct {
+		arg1 int
 	}
-	newProjectReturns struct {
-		result1 *pt.Project
+	listIntegrationsReturns struct {
+		result1 []pt.Integration
 		result2 *http.Response
 		result3 error
 	}
-	newProjectReturnsOnCall map[int]struct {
-		result1 *pt.Project
+	listIntegrationsReturnsOnCall map[int]struct {
+		result1 []pt.Integration
 		result2 *http.Response
 		result3 error
 	}
-	UpdateAccountMemberStub        func(int, int, pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error)
-	updateAccountMemberMutex       sync.RWMutex
-	updateAccountMemberArgsForCall []struct {
+	ListIterationOverridesStub        func(int) ([]pt.IterationOverride, *http.Response, error)
+	listIterationOverridesMutex       sync.RWMutex
+	listIterationOverridesArgsForCall []struct {
 		arg1 int
-		arg2 int
-		arg3 pt.AccountMemberRequest
 	}
-	updateAccountMemberReturns struct {
-		result1 *pt.AccountMember
+	listIterationOverridesReturns struct {
+		result1 []pt.IterationOverride
 		result2 *http.Response
 		result3 error
 	}
-	updateAccountMemberReturnsOnCall map[int]struct {
-		result1 *pt.AccountMember
+	listIterationOverridesReturnsOnCall map[int]struct {
+		result1 []pt.IterationOverride
 		result2 *http.Response
 		result3 error
 	}
-	UpdateProjectStub        func(int, pt.ProjectRequest) (*pt.Project, *http.Response, error)
-	updateProjectMutex       sync.RWMutex
-	updateProjectArgsForCall []struct {
+	ListLabelsStub        func(int) ([]pt.Label, *http.Response, error)
+	listLabelsMutex       sync.RWMutex
+	listLabelsArgsForCall []struct {
 		arg1 int
-		arg2 pt.ProjectRequest
 	}
-	updateProjectReturns struct {
-		result1 *pt.Project
+	listLabelsReturns struct {
+		result1 []pt.Label
 		result2 *http.Response
 		result3 error
 	}
-	updateProjectReturnsOnCall map[int]struct {
-		result1 *pt.Project
+	listLabelsReturnsOnCall map[int]struct {
+		result1 []pt.Label
 		result2 *http.Response
 		result3 error
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
-
-func (fake *FakeClientCaller) DeleteAccountMember(arg1 int, arg2 int) (*http.Response, error) {
-	fake.deleteAccountMemberMutex.Lock()
-	ret, specificReturn := fake.deleteAccountMemberReturnsOnCall[len(fake.deleteAccountMemberArgsForCall)]
-	fake.deleteAccountMemberArgsForCall = append(fake.deleteAccountMemberArgsForCall, struct {
+	ListProjectMembershipsStub        func(int) ([]pt.ProjectMembershipResponse, *http.Response, error)
+	listProjectMembershipsMutex       sync.RWMutex
+	listProjectMembershipsArgsForCall []struct {
 		arg1 int
-		arg2 int
-	}{arg1, arg2})
-	fake.recordInvocation("DeleteAccountMember", []interface{}{arg1, arg2})
-	fake.deleteAccountMemberMutex.Unlock()
-	if fake.DeleteAccountMemberStub != nil {
+	}
+	listProjectMembershipsReturns struct {
+		result1 []pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	listProjectMembershipsReturnsOnCall map[int]struct {
+		result1 []pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	ListProjectsStub        func() ([]*pt.Project, *http.Response, error)
+	listProjectsMutex       sync.RWMutex
+	listProjectsArgsForCall []struct {
+	}
+	listProjectsReturns struct {
+		result1 []*pt.Project
+		result2 *http.Response
+		result3 error
+	}
+	listProjectsReturnsOnCall map[int]struct {
+		result1 []*pt.Project
+		result2 *http.Response
+		result3 error
+	}
+	ListReviewTypesStub        func(int) ([]pt.ReviewType, *http.Response, error)
+	listReviewTypesMutex       sync.RWMutex
+	listReviewTypesArgsForCall []struct {
+		arg1 int
+	}
+	listReviewTypesReturns struct {
+		result1 []pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	listReviewTypesReturnsOnCall map[int]struct {
+		result1 []pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	ListReviewsStub        func(int, int) ([]pt.Review, *http.Response, error)
+	listReviewsMutex       sync.RWMutex
+	listReviewsArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	listReviewsReturns struct {
+		result1 []pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	listReviewsReturnsOnCall map[int]struct {
+		result1 []pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	ListStoriesStub        func(int) ([]pt.Story, *http.Response, error)
+	listStoriesMutex       sync.RWMutex
+	listStoriesArgsForCall []struct {
+		arg1 int
+	}
+	listStoriesReturns struct {
+		result1 []pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	listStoriesReturnsOnCall map[int]struct {
+		result1 []pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	ListTasksStub        func(int, int) ([]pt.Task, *http.Response, error)
+	listTasksMutex       sync.RWMutex
+	listTasksArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	listTasksReturns struct {
+		result1 []pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	listTasksReturnsOnCall map[int]struct {
+		result1 []pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	ListWebhooksStub        func(int) ([]pt.Webhook, *http.Response, error)
+	listWebhooksMutex       sync.RWMutex
+	listWebhooksArgsForCall []struct {
+		arg1 int
+	}
+	listWebhooksReturns struct {
+		result1 []pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	listWebhooksReturnsOnCall map[int]struct {
+		result1 []pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	ListWorkspacesStub        func() ([]pt.Workspace, *http.Response, error)
+	listWorkspacesMutex       sync.RWMutex
+	listWorkspacesArgsForCall []struct {
+	}
+	listWorkspacesReturns struct {
+		result1 []pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	listWorkspacesReturnsOnCall map[int]struct {
+		result1 []pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	NewAccountMemberStub        func(int, pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error)
+	newAccountMemberMutex       sync.RWMutex
+	newAccountMemberArgsForCall []struct {
+		arg1 int
+		arg2 pt.AccountMemberRequest
+	}
+	newAccountMemberReturns struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}
+	newAccountMemberReturnsOnCall map[int]struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}
+	NewEpicStub        func(int, pt.EpicRequest) (*pt.Epic, *http.Response, error)
+	newEpicMutex       sync.RWMutex
+	newEpicArgsForCall []struct {
+		arg1 int
+		arg2 pt.EpicRequest
+	}
+	newEpicReturns struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	newEpicReturnsOnCall map[int]struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	NewIntegrationStub        func(int, pt.IntegrationRequest) (*pt.Integration, *http.Response, error)
+	newIntegrationMutex       sync.RWMutex
+	newIntegrationArgsForCall []struct {
+		arg1 int
+		arg2 pt.IntegrationRequest
+	}
+	newIntegrationReturns struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	newIntegrationReturnsOnCall map[int]struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	NewLabelStub        func(int, pt.LabelRequest) (*pt.Label, *http.Response, error)
+	newLabelMutex       sync.RWMutex
+	newLabelArgsForCall []struct {
+		arg1 int
+		arg2 pt.LabelRequest
+	}
+	newLabelReturns struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	newLabelReturnsOnCall map[int]struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	NewProjectStub        func(pt.ProjectsRequest) (*pt.Project, *http.Response, error)
+	newProjectMutex       sync.RWMutex
+	newProjectArgsForCall []struct {
+		arg1 pt.ProjectsRequest
+	}
+	newProjectReturns struct {
+		result1 *pt.Project
+		result2 *http.Response
+		result3 error
+	}
+	newProjectReturnsOnCall map[int]struct {
+		result1 *pt.Project
+		result2 *http.Response
+		result3 error
+	}
+	NewProjectMembershipStub        func(int, pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error)
+	newProjectMembershipMutex       sync.RWMutex
+	newProjectMembershipArgsForCall []struct {
+		arg1 int
+		arg2 pt.ProjectMembershipRequest
+	}
+	newProjectMembershipReturns struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	newProjectMembershipReturnsOnCall map[int]struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	NewReviewStub        func(int, int, pt.ReviewRequest) (*pt.Review, *http.Response, error)
+	newReviewMutex       sync.RWMutex
+	newReviewArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ReviewRequest
+	}
+	newReviewReturns struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	newReviewReturnsOnCall map[int]struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	NewReviewTypeStub        func(int, pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error)
+	newReviewTypeMutex       sync.RWMutex
+	newReviewTypeArgsForCall []struct {
+		arg1 int
+		arg2 pt.ReviewTypeRequest
+	}
+	newReviewTypeReturns struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	newReviewTypeReturnsOnCall map[int]struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	NewStoryStub        func(int, pt.StoryRequest) (*pt.Story, *http.Response, error)
+	newStoryMutex       sync.RWMutex
+	newStoryArgsForCall []struct {
+		arg1 int
+		arg2 pt.StoryRequest
+	}
+	newStoryReturns struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	newStoryReturnsOnCall map[int]struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	NewTaskStub        func(int, int, pt.TaskRequest) (*pt.Task, *http.Response, error)
+	newTaskMutex       sync.RWMutex
+	newTaskArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.TaskRequest
+	}
+	newTaskReturns struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	newTaskReturnsOnCall map[int]struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	NewWebhookStub        func(int, pt.WebhookRequest) (*pt.Webhook, *http.Response, error)
+	newWebhookMutex       sync.RWMutex
+	newWebhookArgsForCall []struct {
+		arg1 int
+		arg2 pt.WebhookRequest
+	}
+	newWebhookReturns struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	newWebhookReturnsOnCall map[int]struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	NewWorkspaceStub        func(pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error)
+	newWorkspaceMutex       sync.RWMutex
+	newWorkspaceArgsForCall []struct {
+		arg1 pt.WorkspaceRequest
+	}
+	newWorkspaceReturns struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	newWorkspaceReturnsOnCall map[int]struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	UpdateAccountStub        func(int, pt.AccountRequest) (*pt.Account, *http.Response, error)
+	updateAccountMutex       sync.RWMutex
+	updateAccountArgsForCall []struct {
+		arg1 int
+		arg2 pt.AccountRequest
+	}
+	updateAccountReturns struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}
+	updateAccountReturnsOnCall map[int]struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}
+	UpdateAccountMemberStub        func(int, int, pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error)
+	updateAccountMemberMutex       sync.RWMutex
+	updateAccountMemberArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.AccountMemberRequest
+	}
+	updateAccountMemberReturns struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}
+	updateAccountMemberReturnsOnCall map[int]struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}
+	UpdateEpicStub        func(int, int, pt.EpicRequest) (*pt.Epic, *http.Response, error)
+	updateEpicMutex       sync.RWMutex
+	updateEpicArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.EpicRequest
+	}
+	updateEpicReturns struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	updateEpicReturnsOnCall map[int]struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	UpdateIntegrationStub        func(int, int, pt.IntegrationRequest) (*pt.Integration, *http.Response, error)
+	updateIntegrationMutex       sync.RWMutex
+	updateIntegrationArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.IntegrationRequest
+	}
+	updateIntegrationReturns struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	updateIntegrationReturnsOnCall map[int]struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	UpdateIterationOverrideStub        func(int, int, pt.IterationOverrideRequest) (*pt.IterationOverride, *http.Response, error)
+	updateIterationOverrideMutex       sync.RWMutex
+	updateIterationOverrideArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.IterationOverrideRequest
+	}
+	updateIterationOverrideReturns struct {
+		result1 *pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}
+	updateIterationOverrideReturnsOnCall map[int]struct {
+		result1 *pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}
+	UpdateLabelStub        func(int, int, pt.LabelRequest) (*pt.Label, *http.Response, error)
+	updateLabelMutex       sync.RWMutex
+	updateLabelArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.LabelRequest
+	}
+	updateLabelReturns struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	updateLabelReturnsOnCall map[int]struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	UpdateProjectStub        func(int, pt.ProjectRequest) (*pt.Project, *http.Response, error)
+	updateProjectMutex       sync.RWMutex
+	updateProjectArgsForCall []struct {
+		arg1 int
+		arg2 pt.ProjectRequest
+	}
+	updateProjectReturns struct {
+		result1 *pt.Project
+		result2 *http.Response
+		result3 error
+	}
+	updateProjectReturnsOnCall map[int]struct {
+		result1 *pt.Project
+		result2 *http.Response
+		result3 error
+	}
+	UpdateProjectMembershipStub        func(int, int, pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error)
+	updateProjectMembershipMutex       sync.RWMutex
+	updateProjectMembershipArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ProjectMembershipRequest
+	}
+	updateProjectMembershipReturns struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	updateProjectMembershipReturnsOnCall map[int]struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	UpdateReviewStub        func(int, int, int, pt.ReviewRequest) (*pt.Review, *http.Response, error)
+	updateReviewMutex       sync.RWMutex
+	updateReviewArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+		arg4 pt.ReviewRequest
+	}
+	updateReviewReturns struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	updateReviewReturnsOnCall map[int]struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	UpdateReviewTypeStub        func(int, int, pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error)
+	updateReviewTypeMutex       sync.RWMutex
+	updateReviewTypeArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ReviewTypeRequest
+	}
+	updateReviewTypeReturns struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	updateReviewTypeReturnsOnCall map[int]struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	UpdateStoryStub        func(int, int, pt.StoryRequest) (*pt.Story, *http.Response, error)
+	updateStoryMutex       sync.RWMutex
+	updateStoryArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.StoryRequest
+	}
+	updateStoryReturns struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	updateStoryReturnsOnCall map[int]struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	UpdateTaskStub        func(int, int, int, pt.TaskRequest) (*pt.Task, *http.Response, error)
+	updateTaskMutex       sync.RWMutex
+	updateTaskArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+		arg4 pt.TaskRequest
+	}
+	updateTaskReturns struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	updateTaskReturnsOnCall map[int]struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	UpdateWebhookStub        func(int, int, pt.WebhookRequest) (*pt.Webhook, *http.Response, error)
+	updateWebhookMutex       sync.RWMutex
+	updateWebhookArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.WebhookRequest
+	}
+	updateWebhookReturns struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	updateWebhookReturnsOnCall map[int]struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	UpdateWorkspaceStub        func(int, pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error)
+	updateWorkspaceMutex       sync.RWMutex
+	updateWorkspaceArgsForCall []struct {
+		arg1 int
+		arg2 pt.WorkspaceRequest
+	}
+	updateWorkspaceReturns struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	updateWorkspaceReturnsOnCall map[int]struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClientCaller) DeleteAccountMember(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteAccountMemberMutex.Lock()
+	ret, specificReturn := fake.deleteAccountMemberReturnsOnCall[len(fake.deleteAccountMemberArgsForCall)]
+	fake.deleteAccountMemberArgsForCall = append(fake.deleteAccountMemberArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteAccountMember", []interface{}{arg1, arg2})
+	fake.deleteAccountMemberMutex.Unlock()
+	if fake.DeleteAccountMemberStub != nil {
 		return fake.DeleteAccountMemberStub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteAccountMemberReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteAccountMemberCallCount() int {
+	fake.deleteAccountMemberMutex.RLock()
+	defer fake.deleteAccountMemberMutex.RUnlock()
+	return len(fake.deleteAccountMemberArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteAccountMemberCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteAccountMemberMutex.Lock()
+	defer fake.deleteAccountMemberMutex.Unlock()
+	fake.DeleteAccountMemberStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteAccountMemberArgsForCall(i int) (int, int) {
+	fake.deleteAccountMemberMutex.RLock()
+	defer fake.deleteAccountMemberMutex.RUnlock()
+	argsForCall := fake.deleteAccountMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) DeleteAccountMemberReturns(result1 *http.Response, result2 error) {
+	fake.deleteAccountMemberMutex.Lock()
+	defer fake.deleteAccountMemberMutex.Unlock()
+	fake.DeleteAccountMemberStub = nil
+	fake.deleteAccountMemberReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteAccountMemberReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteAccountMemberMutex.Lock()
+	defer fake.deleteAccountMemberMutex.Unlock()
+	fake.DeleteAccountMemberStub = nil
+	if fake.deleteAccountMemberReturnsOnCall == nil {
+		fake.deleteAccountMemberReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteAccountMemberReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteEpic(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteEpicMutex.Lock()
+	ret, specificReturn := fake.deleteEpicReturnsOnCall[len(fake.deleteEpicArgsForCall)]
+	fake.deleteEpicArgsForCall = append(fake.deleteEpicArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteEpic", []interface{}{arg1, arg2})
+	fake.deleteEpicMutex.Unlock()
+	if fake.DeleteEpicStub != nil {
+		return fake.DeleteEpicStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteEpicReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteEpicCallCount() int {
+	fake.deleteEpicMutex.RLock()
+	defer fake.deleteEpicMutex.RUnlock()
+	return len(fake.deleteEpicArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteEpicCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteEpicMutex.Lock()
+	defer fake.deleteEpicMutex.Unlock()
+	fake.DeleteEpicStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteEpicArgsForCall(i int) (int, int) {
+	fake.deleteEpicMutex.RLock()
+	defer fake.deleteEpicMutex.RUnlock()
+	argsForCall := fake.deleteEpicArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) DeleteEpicReturns(result1 *http.Response, result2 error) {
+	fake.deleteEpicMutex.Lock()
+	defer fake.deleteEpicMutex.Unlock()
+	fake.DeleteEpicStub = nil
+	fake.deleteEpicReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteEpicReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteEpicMutex.Lock()
+	defer fake.deleteEpicMutex.Unlock()
+	fake.DeleteEpicStub = nil
+	if fake.deleteEpicReturnsOnCall == nil {
+		fake.deleteEpicReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteEpicReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteIntegration(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteIntegrationMutex.Lock()
+	ret, specificReturn := fake.deleteIntegrationReturnsOnCall[len(fake.deleteIntegrationArgsForCall)]
+	fake.deleteIntegrationArgsForCall = append(fake.deleteIntegrationArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteIntegration", []interface{}{arg1, arg2})
+	fake.deleteIntegrationMutex.Unlock()
+	if fake.DeleteIntegrationStub != nil {
+		return fake.DeleteIntegrationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteIntegrationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteIntegrationCallCount() int {
+	fake.deleteIntegrationMutex.RLock()
+	defer fake.deleteIntegrationMutex.RUnlock()
+	return len(fake.deleteIntegrationArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteIntegrationCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteIntegrationMutex.Lock()
+	defer fake.deleteIntegrationMutex.Unlock()
+	fake.DeleteIntegrationStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteIntegrationArgsForCall(i int) (int, int) {
+	fake.deleteIntegrationMutex.RLock()
+	defer fake.deleteIntegrationMutex.RUnlock()
+	argsForCall := fake.deleteIntegrationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) DeleteIntegrationReturns(result1 *http.Response, result2 error) {
+	fake.deleteIntegrationMutex.Lock()
+	defer fake.deleteIntegrationMutex.Unlock()
+	fake.DeleteIntegrationStub = nil
+	fake.deleteIntegrationReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteIntegrationReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteIntegrationMutex.Lock()
+	defer fake.deleteIntegrationMutex.Unlock()
+	fake.DeleteIntegrationStub = nil
+	if fake.deleteIntegrationReturnsOnCall == nil {
+		fake.deleteIntegrationReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteIntegrationReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteLabel(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteLabelMutex.Lock()
+	ret, specificReturn := fake.deleteLabelReturnsOnCall[len(fake.deleteLabelArgsForCall)]
+	fake.deleteLabelArgsForCall = append(fake.deleteLabelArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteLabel", []interface{}{arg1, arg2})
+	fake.deleteLabelMutex.Unlock()
+	if fake.DeleteLabelStub != nil {
+		return fake.DeleteLabelStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteLabelCallCount() int {
+	fake.deleteLabelMutex.RLock()
+	defer fake.deleteLabelMutex.RUnlock()
+	return len(fake.deleteLabelArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteLabelCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteLabelMutex.Lock()
+	defer fake.deleteLabelMutex.Unlock()
+	fake.DeleteLabelStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteLabelArgsForCall(i int) (int, int) {
+	fake.deleteLabelMutex.RLock()
+	defer fake.deleteLabelMutex.RUnlock()
+	argsForCall := fake.deleteLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) DeleteLabelReturns(result1 *http.Response, result2 error) {
+	fake.deleteLabelMutex.Lock()
+	defer fake.deleteLabelMutex.Unlock()
+	fake.DeleteLabelStub = nil
+	fake.deleteLabelReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteLabelReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteLabelMutex.Lock()
+	defer fake.deleteLabelMutex.Unlock()
+	fake.DeleteLabelStub = nil
+	if fake.deleteLabelReturnsOnCall == nil {
+		fake.deleteLabelReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteLabelReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteProject(arg1 int) (*http.Response, error) {
+	fake.deleteProjectMutex.Lock()
+	ret, specificReturn := fake.deleteProjectReturnsOnCall[len(fake.deleteProjectArgsForCall)]
+	fake.deleteProjectArgsForCall = append(fake.deleteProjectArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteProject", []interface{}{arg1})
+	fake.deleteProjectMutex.Unlock()
+	if fake.DeleteProjectStub != nil {
+		return fake.DeleteProjectStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteProjectReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteProjectCallCount() int {
+	fake.deleteProjectMutex.RLock()
+	defer fake.deleteProjectMutex.RUnlock()
+	return len(fake.deleteProjectArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteProjectCalls(stub func(int) (*http.Response, error)) {
+	fake.deleteProjectMutex.Lock()
+	defer fake.deleteProjectMutex.Unlock()
+	fake.DeleteProjectStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteProjectArgsForCall(i int) int {
+	fake.deleteProjectMutex.RLock()
+	defer fake.deleteProjectMutex.RUnlock()
+	argsForCall := fake.deleteProjectArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) DeleteProjectReturns(result1 *http.Response, result2 error) {
+	fake.deleteProjectMutex.Lock()
+	defer fake.deleteProjectMutex.Unlock()
+	fake.DeleteProjectStub = nil
+	fake.deleteProjectReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteProjectReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteProjectMutex.Lock()
+	defer fake.deleteProjectMutex.Unlock()
+	fake.DeleteProjectStub = nil
+	if fake.deleteProjectReturnsOnCall == nil {
+		fake.deleteProjectReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteProjectReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteProjectMembership(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteProjectMembershipMutex.Lock()
+	ret, specificReturn := fake.deleteProjectMembershipReturnsOnCall[len(fake.deleteProjectMembershipArgsForCall)]
+	fake.deleteProjectMembershipArgsForCall = append(fake.deleteProjectMembershipArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteProjectMembership", []interface{}{arg1, arg2})
+	fake.deleteProjectMembershipMutex.Unlock()
+	if fake.DeleteProjectMembershipStub != nil {
+		return fake.DeleteProjectMembershipStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteProjectMembershipReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteProjectMembershipCallCount() int {
+	fake.deleteProjectMembershipMutex.RLock()
+	defer fake.deleteProjectMembershipMutex.RUnlock()
+	return len(fake.deleteProjectMembershipArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteProjectMembershipCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteProjectMembershipMutex.Lock()
+	defer fake.deleteProjectMembershipMutex.Unlock()
+	fake.DeleteProjectMembershipStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteProjectMembershipArgsForCall(i int) (int, int) {
+	fake.deleteProjectMembershipMutex.RLock()
+	defer fake.deleteProjectMembershipMutex.RUnlock()
+	argsForCall := fake.deleteProjectMembershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) DeleteProjectMembershipReturns(result1 *http.Response, result2 error) {
+	fake.deleteProjectMembershipMutex.Lock()
+	defer fake.deleteProjectMembershipMutex.Unlock()
+	fake.DeleteProjectMembershipStub = nil
+	fake.deleteProjectMembershipReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteProjectMembershipReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteProjectMembershipMutex.Lock()
+	defer fake.deleteProjectMembershipMutex.Unlock()
+	fake.DeleteProjectMembershipStub = nil
+	if fake.deleteProjectMembershipReturnsOnCall == nil {
+		fake.deleteProjectMembershipReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteProjectMembershipReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteReview(arg1 int, arg2 int, arg3 int) (*http.Response, error) {
+	fake.deleteReviewMutex.Lock()
+	ret, specificReturn := fake.deleteReviewReturnsOnCall[len(fake.deleteReviewArgsForCall)]
+	fake.deleteReviewArgsForCall = append(fake.deleteReviewArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteReview", []interface{}{arg1, arg2, arg3})
+	fake.deleteReviewMutex.Unlock()
+	if fake.DeleteReviewStub != nil {
+		return fake.DeleteReviewStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteReviewReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteReviewCallCount() int {
+	fake.deleteReviewMutex.RLock()
+	defer fake.deleteReviewMutex.RUnlock()
+	return len(fake.deleteReviewArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteReviewCalls(stub func(int, int, int) (*http.Response, error)) {
+	fake.deleteReviewMutex.Lock()
+	defer fake.deleteReviewMutex.Unlock()
+	fake.DeleteReviewStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteReviewArgsForCall(i int) (int, int, int) {
+	fake.deleteReviewMutex.RLock()
+	defer fake.deleteReviewMutex.RUnlock()
+	argsForCall := fake.deleteReviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) DeleteReviewReturns(result1 *http.Response, result2 error) {
+	fake.deleteReviewMutex.Lock()
+	defer fake.deleteReviewMutex.Unlock()
+	fake.DeleteReviewStub = nil
+	fake.deleteReviewReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteReviewReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteReviewMutex.Lock()
+	defer fake.deleteReviewMutex.Unlock()
+	fake.DeleteReviewStub = nil
+	if fake.deleteReviewReturnsOnCall == nil {
+		fake.deleteReviewReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteReviewReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteReviewType(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteReviewTypeMutex.Lock()
+	ret, specificReturn := fake.deleteReviewTypeReturnsOnCall[len(fake.deleteReviewTypeArgsForCall)]
+	fake.deleteReviewTypeArgsForCall = append(fake.deleteReviewTypeArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteReviewType", []interface{}{arg1, arg2})
+	fake.deleteReviewTypeMutex.Unlock()
+	if fake.DeleteReviewTypeStub != nil {
+		return fake.DeleteReviewTypeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteReviewTypeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteReviewTypeCallCount() int {
+	fake.deleteReviewTypeMutex.RLock()
+	defer fake.deleteReviewTypeMutex.RUnlock()
+	return len(fake.deleteReviewTypeArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteReviewTypeCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteReviewTypeMutex.Lock()
+	defer fake.deleteReviewTypeMutex.Unlock()
+	fake.DeleteReviewTypeStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteReviewTypeArgsForCall(i int) (int, int) {
+	fake.deleteReviewTypeMutex.RLock()
+	defer fake.deleteReviewTypeMutex.RUnlock()
+	argsForCall := fake.deleteReviewTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) DeleteReviewTypeReturns(result1 *http.Response, result2 error) {
+	fake.deleteReviewTypeMutex.Lock()
+	defer fake.deleteReviewTypeMutex.Unlock()
+	fake.DeleteReviewTypeStub = nil
+	fake.deleteReviewTypeReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteReviewTypeReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteReviewTypeMutex.Lock()
+	defer fake.deleteReviewTypeMutex.Unlock()
+	fake.DeleteReviewTypeStub = nil
+	if fake.deleteReviewTypeReturnsOnCall == nil {
+		fake.deleteReviewTypeReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteReviewTypeReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteStory(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteStoryMutex.Lock()
+	ret, specificReturn := fake.deleteStoryReturnsOnCall[len(fake.deleteStoryArgsForCall)]
+	fake.deleteStoryArgsForCall = append(fake.deleteStoryArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteStory", []interface{}{arg1, arg2})
+	fake.deleteStoryMutex.Unlock()
+	if fake.DeleteStoryStub != nil {
+		return fake.DeleteStoryStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteStoryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteStoryCallCount() int {
+	fake.deleteStoryMutex.RLock()
+	defer fake.deleteStoryMutex.RUnlock()
+	return len(fake.deleteStoryArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteStoryCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteStoryMutex.Lock()
+	defer fake.deleteStoryMutex.Unlock()
+	fake.DeleteStoryStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteStoryArgsForCall(i int) (int, int) {
+	fake.deleteStoryMutex.RLock()
+	defer fake.deleteStoryMutex.RUnlock()
+	argsForCall := fake.deleteStoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) DeleteStoryReturns(result1 *http.Response, result2 error) {
+	fake.deleteStoryMutex.Lock()
+	defer fake.deleteStoryMutex.Unlock()
+	fake.DeleteStoryStub = nil
+	fake.deleteStoryReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteStoryReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteStoryMutex.Lock()
+	defer fake.deleteStoryMutex.Unlock()
+	fake.DeleteStoryStub = nil
+	if fake.deleteStoryReturnsOnCall == nil {
+		fake.deleteStoryReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteStoryReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteTask(arg1 int, arg2 int, arg3 int) (*http.Response, error) {
+	fake.deleteTaskMutex.Lock()
+	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
+	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteTaskMutex.Unlock()
+	if fake.DeleteTaskStub != nil {
+		return fake.DeleteTaskStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteTaskReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteTaskCallCount() int {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	return len(fake.deleteTaskArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteTaskCalls(stub func(int, int, int) (*http.Response, error)) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteTaskArgsForCall(i int) (int, int, int) {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	argsForCall := fake.deleteTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) DeleteTaskReturns(result1 *http.Response, result2 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	fake.deleteTaskReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteTaskReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	if fake.deleteTaskReturnsOnCall == nil {
+		fake.deleteTaskReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteTaskReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteWebhook(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteWebhookMutex.Lock()
+	ret, specificReturn := fake.deleteWebhookReturnsOnCall[len(fake.deleteWebhookArgsForCall)]
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteWebhook", []interface{}{arg1, arg2})
+	fake.deleteWebhookMutex.Unlock()
+	if fake.DeleteWebhookStub != nil {
+		return fake.DeleteWebhookStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteWebhookCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteWebhookArgsForCall(i int) (int, int) {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	argsForCall := fake.deleteWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) DeleteWebhookReturns(result1 *http.Response, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteWebhookReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	if fake.deleteWebhookReturnsOnCall == nil {
+		fake.deleteWebhookReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteWebhookReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteWorkspace(arg1 int) (*http.Response, error) {
+	fake.deleteWorkspaceMutex.Lock()
+	ret, specificReturn := fake.deleteWorkspaceReturnsOnCall[len(fake.deleteWorkspaceArgsForCall)]
+	fake.deleteWorkspaceArgsForCall = append(fake.deleteWorkspaceArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteWorkspace", []interface{}{arg1})
+	fake.deleteWorkspaceMutex.Unlock()
+	if fake.DeleteWorkspaceStub != nil {
+		return fake.DeleteWorkspaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWorkspaceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClientCaller) DeleteWorkspaceCallCount() int {
+	fake.deleteWorkspaceMutex.RLock()
+	defer fake.deleteWorkspaceMutex.RUnlock()
+	return len(fake.deleteWorkspaceArgsForCall)
+}
+
+func (fake *FakeClientCaller) DeleteWorkspaceCalls(stub func(int) (*http.Response, error)) {
+	fake.deleteWorkspaceMutex.Lock()
+	defer fake.deleteWorkspaceMutex.Unlock()
+	fake.DeleteWorkspaceStub = stub
+}
+
+func (fake *FakeClientCaller) DeleteWorkspaceArgsForCall(i int) int {
+	fake.deleteWorkspaceMutex.RLock()
+	defer fake.deleteWorkspaceMutex.RUnlock()
+	argsForCall := fake.deleteWorkspaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) DeleteWorkspaceReturns(result1 *http.Response, result2 error) {
+	fake.deleteWorkspaceMutex.Lock()
+	defer fake.deleteWorkspaceMutex.Unlock()
+	fake.DeleteWorkspaceStub = nil
+	fake.deleteWorkspaceReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) DeleteWorkspaceReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteWorkspaceMutex.Lock()
+	defer fake.deleteWorkspaceMutex.Unlock()
+	fake.DeleteWorkspaceStub = nil
+	if fake.deleteWorkspaceReturnsOnCall == nil {
+		fake.deleteWorkspaceReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteWorkspaceReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClientCaller) GetAccount(arg1 int) (*pt.Account, *http.Response, error) {
+	fake.getAccountMutex.Lock()
+	ret, specificReturn := fake.getAccountReturnsOnCall[len(fake.getAccountArgsForCall)]
+	fake.getAccountArgsForCall = append(fake.getAccountArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("GetAccount", []interface{}{arg1})
+	fake.getAccountMutex.Unlock()
+	if fake.GetAccountStub != nil {
+		return fake.GetAccountStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAccountReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetAccountCallCount() int {
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	return len(fake.getAccountArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetAccountCalls(stub func(int) (*pt.Account, *http.Response, error)) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = stub
+}
+
+func (fake *FakeClientCaller) GetAccountArgsForCall(i int) int {
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	argsForCall := fake.getAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) GetAccountReturns(result1 *pt.Account, result2 *http.Response, result3 error) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = nil
+	fake.getAccountReturns = struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetAccountReturnsOnCall(i int, result1 *pt.Account, result2 *http.Response, result3 error) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = nil
+	if fake.getAccountReturnsOnCall == nil {
+		fake.getAccountReturnsOnCall = make(map[int]struct {
+			result1 *pt.Account
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getAccountReturnsOnCall[i] = struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetAccountMember(arg1 int, arg2 int) (*pt.AccountMember, *http.Response, error) {
+	fake.getAccountMemberMutex.Lock()
+	ret, specificReturn := fake.getAccountMemberReturnsOnCall[len(fake.getAccountMemberArgsForCall)]
+	fake.getAccountMemberArgsForCall = append(fake.getAccountMemberArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetAccountMember", []interface{}{arg1, arg2})
+	fake.getAccountMemberMutex.Unlock()
+	if fake.GetAccountMemberStub != nil {
+		return fake.GetAccountMemberStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAccountMemberReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetAccountMemberCallCount() int {
+	fake.getAccountMemberMutex.RLock()
+	defer fake.getAccountMemberMutex.RUnlock()
+	return len(fake.getAccountMemberArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetAccountMemberCalls(stub func(int, int) (*pt.AccountMember, *http.Response, error)) {
+	fake.getAccountMemberMutex.Lock()
+	defer fake.getAccountMemberMutex.Unlock()
+	fake.GetAccountMemberStub = stub
+}
+
+func (fake *FakeClientCaller) GetAccountMemberArgsForCall(i int) (int, int) {
+	fake.getAccountMemberMutex.RLock()
+	defer fake.getAccountMemberMutex.RUnlock()
+	argsForCall := fake.getAccountMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) GetAccountMemberReturns(result1 *pt.AccountMember, result2 *http.Response, result3 error) {
+	fake.getAccountMemberMutex.Lock()
+	defer fake.getAccountMemberMutex.Unlock()
+	fake.GetAccountMemberStub = nil
+	fake.getAccountMemberReturns = struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetAccountMemberReturnsOnCall(i int, result1 *pt.AccountMember, result2 *http.Response, result3 error) {
+	fake.getAccountMemberMutex.Lock()
+	defer fake.getAccountMemberMutex.Unlock()
+	fake.GetAccountMemberStub = nil
+	if fake.getAccountMemberReturnsOnCall == nil {
+		fake.getAccountMemberReturnsOnCall = make(map[int]struct {
+			result1 *pt.AccountMember
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getAccountMemberReturnsOnCall[i] = struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetEpic(arg1 int, arg2 int) (*pt.Epic, *http.Response, error) {
+	fake.getEpicMutex.Lock()
+	ret, specificReturn := fake.getEpicReturnsOnCall[len(fake.getEpicArgsForCall)]
+	fake.getEpicArgsForCall = append(fake.getEpicArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetEpic", []interface{}{arg1, arg2})
+	fake.getEpicMutex.Unlock()
+	if fake.GetEpicStub != nil {
+		return fake.GetEpicStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEpicReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetEpicCallCount() int {
+	fake.getEpicMutex.RLock()
+	defer fake.getEpicMutex.RUnlock()
+	return len(fake.getEpicArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetEpicCalls(stub func(int, int) (*pt.Epic, *http.Response, error)) {
+	fake.getEpicMutex.Lock()
+	defer fake.getEpicMutex.Unlock()
+	fake.GetEpicStub = stub
+}
+
+func (fake *FakeClientCaller) GetEpicArgsForCall(i int) (int, int) {
+	fake.getEpicMutex.RLock()
+	defer fake.getEpicMutex.RUnlock()
+	argsForCall := fake.getEpicArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) GetEpicReturns(result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.getEpicMutex.Lock()
+	defer fake.getEpicMutex.Unlock()
+	fake.GetEpicStub = nil
+	fake.getEpicReturns = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetEpicReturnsOnCall(i int, result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.getEpicMutex.Lock()
+	defer fake.getEpicMutex.Unlock()
+	fake.GetEpicStub = nil
+	if fake.getEpicReturnsOnCall == nil {
+		fake.getEpicReturnsOnCall = make(map[int]struct {
+			result1 *pt.Epic
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getEpicReturnsOnCall[i] = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetIntegration(arg1 int, arg2 int) (*pt.Integration, *http.Response, error) {
+	fake.getIntegrationMutex.Lock()
+	ret, specificReturn := fake.getIntegrationReturnsOnCall[len(fake.getIntegrationArgsForCall)]
+	fake.getIntegrationArgsForCall = append(fake.getIntegrationArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetIntegration", []interface{}{arg1, arg2})
+	fake.getIntegrationMutex.Unlock()
+	if fake.GetIntegrationStub != nil {
+		return fake.GetIntegrationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getIntegrationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetIntegrationCallCount() int {
+	fake.getIntegrationMutex.RLock()
+	defer fake.getIntegrationMutex.RUnlock()
+	return len(fake.getIntegrationArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetIntegrationCalls(stub func(int, int) (*pt.Integration, *http.Response, error)) {
+	fake.getIntegrationMutex.Lock()
+	defer fake.getIntegrationMutex.Unlock()
+	fake.GetIntegrationStub = stub
+}
+
+func (fake *FakeClientCaller) GetIntegrationArgsForCall(i int) (int, int) {
+	fake.getIntegrationMutex.RLock()
+	defer fake.getIntegrationMutex.RUnlock()
+	argsForCall := fake.getIntegrationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) GetIntegrationReturns(result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.getIntegrationMutex.Lock()
+	defer fake.getIntegrationMutex.Unlock()
+	fake.GetIntegrationStub = nil
+	fake.getIntegrationReturns = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetIntegrationReturnsOnCall(i int, result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.getIntegrationMutex.Lock()
+	defer fake.getIntegrationMutex.Unlock()
+	fake.GetIntegrationStub = nil
+	if fake.getIntegrationReturnsOnCall == nil {
+		fake.getIntegrationReturnsOnCall = make(map[int]struct {
+			result1 *pt.Integration
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getIntegrationReturnsOnCall[i] = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetIteration(arg1 int, arg2 int) (*pt.Iteration, *http.Response, error) {
+	fake.getIterationMutex.Lock()
+	ret, specificReturn := fake.getIterationReturnsOnCall[len(fake.getIterationArgsForCall)]
+	fake.getIterationArgsForCall = append(fake.getIterationArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetIteration", []interface{}{arg1, arg2})
+	fake.getIterationMutex.Unlock()
+	if fake.GetIterationStub != nil {
+		return fake.GetIterationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getIterationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetIterationCallCount() int {
+	fake.getIterationMutex.RLock()
+	defer fake.getIterationMutex.RUnlock()
+	return len(fake.getIterationArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetIterationCalls(stub func(int, int) (*pt.Iteration, *http.Response, error)) {
+	fake.getIterationMutex.Lock()
+	defer fake.getIterationMutex.Unlock()
+	fake.GetIterationStub = stub
+}
+
+func (fake *FakeClientCaller) GetIterationArgsForCall(i int) (int, int) {
+	fake.getIterationMutex.RLock()
+	defer fake.getIterationMutex.RUnlock()
+	argsForCall := fake.getIterationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) GetIterationReturns(result1 *pt.Iteration, result2 *http.Response, result3 error) {
+	fake.getIterationMutex.Lock()
+	defer fake.getIterationMutex.Unlock()
+	fake.GetIterationStub = nil
+	fake.getIterationReturns = struct {
+		result1 *pt.Iteration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetIterationReturnsOnCall(i int, result1 *pt.Iteration, result2 *http.Response, result3 error) {
+	fake.getIterationMutex.Lock()
+	defer fake.getIterationMutex.Unlock()
+	fake.GetIterationStub = nil
+	if fake.getIterationReturnsOnCall == nil {
+		fake.getIterationReturnsOnCall = make(map[int]struct {
+			result1 *pt.Iteration
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getIterationReturnsOnCall[i] = struct {
+		result1 *pt.Iteration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetLabel(arg1 int, arg2 int) (*pt.Label, *http.Response, error) {
+	fake.getLabelMutex.Lock()
+	ret, specificReturn := fake.getLabelReturnsOnCall[len(fake.getLabelArgsForCall)]
+	fake.getLabelArgsForCall = append(fake.getLabelArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetLabel", []interface{}{arg1, arg2})
+	fake.getLabelMutex.Unlock()
+	if fake.GetLabelStub != nil {
+		return fake.GetLabelStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getLabelReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetLabelCallCount() int {
+	fake.getLabelMutex.RLock()
+	defer fake.getLabelMutex.RUnlock()
+	return len(fake.getLabelArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetLabelCalls(stub func(int, int) (*pt.Label, *http.Response, error)) {
+	fake.getLabelMutex.Lock()
+	defer fake.getLabelMutex.Unlock()
+	fake.GetLabelStub = stub
+}
+
+func (fake *FakeClientCaller) GetLabelArgsForCall(i int) (int, int) {
+	fake.getLabelMutex.RLock()
+	defer fake.getLabelMutex.RUnlock()
+	argsForCall := fake.getLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) GetLabelReturns(result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.getLabelMutex.Lock()
+	defer fake.getLabelMutex.Unlock()
+	fake.GetLabelStub = nil
+	fake.getLabelReturns = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetLabelReturnsOnCall(i int, result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.getLabelMutex.Lock()
+	defer fake.getLabelMutex.Unlock()
+	fake.GetLabelStub = nil
+	if fake.getLabelReturnsOnCall == nil {
+		fake.getLabelReturnsOnCall = make(map[int]struct {
+			result1 *pt.Label
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getLabelReturnsOnCall[i] = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetMe() (*pt.Me, *http.Response, error) {
+	fake.getMeMutex.Lock()
+	ret, specificReturn := fake.getMeReturnsOnCall[len(fake.getMeArgsForCall)]
+	fake.getMeArgsForCall = append(fake.getMeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetMe", []interface{}{})
+	fake.getMeMutex.Unlock()
+	if fake.GetMeStub != nil {
+		return fake.GetMeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getMeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetMeCallCount() int {
+	fake.getMeMutex.RLock()
+	defer fake.getMeMutex.RUnlock()
+	return len(fake.getMeArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetMeCalls(stub func() (*pt.Me, *http.Response, error)) {
+	fake.getMeMutex.Lock()
+	defer fake.getMeMutex.Unlock()
+	fake.GetMeStub = stub
+}
+
+func (fake *FakeClientCaller) GetMeReturns(result1 *pt.Me, result2 *http.Response, result3 error) {
+	fake.getMeMutex.Lock()
+	defer fake.getMeMutex.Unlock()
+	fake.GetMeStub = nil
+	fake.getMeReturns = struct {
+		result1 *pt.Me
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetMeReturnsOnCall(i int, result1 *pt.Me, result2 *http.Response, result3 error) {
+	fake.getMeMutex.Lock()
+	defer fake.getMeMutex.Unlock()
+	fake.GetMeStub = nil
+	if fake.getMeReturnsOnCall == nil {
+		fake.getMeReturnsOnCall = make(map[int]struct {
+			result1 *pt.Me
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getMeReturnsOnCall[i] = struct {
+		result1 *pt.Me
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetProject(arg1 int) (*pt.Project, *http.Response, error) {
+	fake.getProjectMutex.Lock()
+	ret, specificReturn := fake.getProjectReturnsOnCall[len(fake.getProjectArgsForCall)]
+	fake.getProjectArgsForCall = append(fake.getProjectArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("GetProject", []interface{}{arg1})
+	fake.getProjectMutex.Unlock()
+	if fake.GetProjectStub != nil {
+		return fake.GetProjectStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getProjectReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetProjectCallCount() int {
+	fake.getProjectMutex.RLock()
+	defer fake.getProjectMutex.RUnlock()
+	return len(fake.getProjectArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetProjectCalls(stub func(int) (*pt.Project, *http.Response, error)) {
+	fake.getProjectMutex.Lock()
+	defer fake.getProjectMutex.Unlock()
+	fake.GetProjectStub = stub
+}
+
+func (fake *FakeClientCaller) GetProjectArgsForCall(i int) int {
+	fake.getProjectMutex.RLock()
+	defer fake.getProjectMutex.RUnlock()
+	argsForCall := fake.getProjectArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) GetProjectReturns(result1 *pt.Project, result2 *http.Response, result3 error) {
+	fake.getProjectMutex.Lock()
+	defer fake.getProjectMutex.Unlock()
+	fake.GetProjectStub = nil
+	fake.getProjectReturns = struct {
+		result1 *pt.Project
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetProjectReturnsOnCall(i int, result1 *pt.Project, result2 *http.Response, result3 error) {
+	fake.getProjectMutex.Lock()
+	defer fake.getProjectMutex.Unlock()
+	fake.GetProjectStub = nil
+	if fake.getProjectReturnsOnCall == nil {
+		fake.getProjectReturnsOnCall = make(map[int]struct {
+			result1 *pt.Project
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getProjectReturnsOnCall[i] = struct {
+		result1 *pt.Project
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetProjectMembership(arg1 int, arg2 int) (*pt.ProjectMembershipResponse, *http.Response, error) {
+	fake.getProjectMembershipMutex.Lock()
+	ret, specificReturn := fake.getProjectMembershipReturnsOnCall[len(fake.getProjectMembershipArgsForCall)]
+	fake.getProjectMembershipArgsForCall = append(fake.getProjectMembershipArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetProjectMembership", []interface{}{arg1, arg2})
+	fake.getProjectMembershipMutex.Unlock()
+	if fake.GetProjectMembershipStub != nil {
+		return fake.GetProjectMembershipStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getProjectMembershipReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetProjectMembershipCallCount() int {
+	fake.getProjectMembershipMutex.RLock()
+	defer fake.getProjectMembershipMutex.RUnlock()
+	return len(fake.getProjectMembershipArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetProjectMembershipCalls(stub func(int, int) (*pt.ProjectMembershipResponse, *http.Response, error)) {
+	fake.getProjectMembershipMutex.Lock()
+	defer fake.getProjectMembershipMutex.Unlock()
+	fake.GetProjectMembershipStub = stub
+}
+
+func (fake *FakeClientCaller) GetProjectMembershipArgsForCall(i int) (int, int) {
+	fake.getProjectMembershipMutex.RLock()
+	defer fake.getProjectMembershipMutex.RUnlock()
+	argsForCall := fake.getProjectMembershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) GetProjectMembershipReturns(result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.getProjectMembershipMutex.Lock()
+	defer fake.getProjectMembershipMutex.Unlock()
+	fake.GetProjectMembershipStub = nil
+	fake.getProjectMembershipReturns = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetProjectMembershipReturnsOnCall(i int, result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.getProjectMembershipMutex.Lock()
+	defer fake.getProjectMembershipMutex.Unlock()
+	fake.GetProjectMembershipStub = nil
+	if fake.getProjectMembershipReturnsOnCall == nil {
+		fake.getProjectMembershipReturnsOnCall = make(map[int]struct {
+			result1 *pt.ProjectMembershipResponse
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getProjectMembershipReturnsOnCall[i] = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetReview(arg1 int, arg2 int, arg3 int) (*pt.Review, *http.Response, error) {
+	fake.getReviewMutex.Lock()
+	ret, specificReturn := fake.getReviewReturnsOnCall[len(fake.getReviewArgsForCall)]
+	fake.getReviewArgsForCall = append(fake.getReviewArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetReview", []interface{}{arg1, arg2, arg3})
+	fake.getReviewMutex.Unlock()
+	if fake.GetReviewStub != nil {
+		return fake.GetReviewStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReviewReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetReviewCallCount() int {
+	fake.getReviewMutex.RLock()
+	defer fake.getReviewMutex.RUnlock()
+	return len(fake.getReviewArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetReviewCalls(stub func(int, int, int) (*pt.Review, *http.Response, error)) {
+	fake.getReviewMutex.Lock()
+	defer fake.getReviewMutex.Unlock()
+	fake.GetReviewStub = stub
+}
+
+func (fake *FakeClientCaller) GetReviewArgsForCall(i int) (int, int, int) {
+	fake.getReviewMutex.RLock()
+	defer fake.getReviewMutex.RUnlock()
+	argsForCall := fake.getReviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) GetReviewReturns(result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.getReviewMutex.Lock()
+	defer fake.getReviewMutex.Unlock()
+	fake.GetReviewStub = nil
+	fake.getReviewReturns = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetReviewReturnsOnCall(i int, result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.getReviewMutex.Lock()
+	defer fake.getReviewMutex.Unlock()
+	fake.GetReviewStub = nil
+	if fake.getReviewReturnsOnCall == nil {
+		fake.getReviewReturnsOnCall = make(map[int]struct {
+			result1 *pt.Review
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getReviewReturnsOnCall[i] = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetReviewType(arg1 int, arg2 int) (*pt.ReviewType, *http.Response, error) {
+	fake.getReviewTypeMutex.Lock()
+	ret, specificReturn := fake.getReviewTypeReturnsOnCall[len(fake.getReviewTypeArgsForCall)]
+	fake.getReviewTypeArgsForCall = append(fake.getReviewTypeArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetReviewType", []interface{}{arg1, arg2})
+	fake.getReviewTypeMutex.Unlock()
+	if fake.GetReviewTypeStub != nil {
+		return fake.GetReviewTypeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReviewTypeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetReviewTypeCallCount() int {
+	fake.getReviewTypeMutex.RLock()
+	defer fake.getReviewTypeMutex.RUnlock()
+	return len(fake.getReviewTypeArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetReviewTypeCalls(stub func(int, int) (*pt.ReviewType, *http.Response, error)) {
+	fake.getReviewTypeMutex.Lock()
+	defer fake.getReviewTypeMutex.Unlock()
+	fake.GetReviewTypeStub = stub
+}
+
+func (fake *FakeClientCaller) GetReviewTypeArgsForCall(i int) (int, int) {
+	fake.getReviewTypeMutex.RLock()
+	defer fake.getReviewTypeMutex.RUnlock()
+	argsForCall := fake.getReviewTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) GetReviewTypeReturns(result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.getReviewTypeMutex.Lock()
+	defer fake.getReviewTypeMutex.Unlock()
+	fake.GetReviewTypeStub = nil
+	fake.getReviewTypeReturns = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetReviewTypeReturnsOnCall(i int, result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.getReviewTypeMutex.Lock()
+	defer fake.getReviewTypeMutex.Unlock()
+	fake.GetReviewTypeStub = nil
+	if fake.getReviewTypeReturnsOnCall == nil {
+		fake.getReviewTypeReturnsOnCall = make(map[int]struct {
+			result1 *pt.ReviewType
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getReviewTypeReturnsOnCall[i] = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetStory(arg1 int, arg2 int) (*pt.Story, *http.Response, error) {
+	fake.getStoryMutex.Lock()
+	ret, specificReturn := fake.getStoryReturnsOnCall[len(fake.getStoryArgsForCall)]
+	fake.getStoryArgsForCall = append(fake.getStoryArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetStory", []interface{}{arg1, arg2})
+	fake.getStoryMutex.Unlock()
+	if fake.GetStoryStub != nil {
+		return fake.GetStoryStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getStoryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetStoryCallCount() int {
+	fake.getStoryMutex.RLock()
+	defer fake.getStoryMutex.RUnlock()
+	return len(fake.getStoryArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetStoryCalls(stub func(int, int) (*pt.Story, *http.Response, error)) {
+	fake.getStoryMutex.Lock()
+	defer fake.getStoryMutex.Unlock()
+	fake.GetStoryStub = stub
+}
+
+func (fake *FakeClientCaller) GetStoryArgsForCall(i int) (int, int) {
+	fake.getStoryMutex.RLock()
+	defer fake.getStoryMutex.RUnlock()
+	argsForCall := fake.getStoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) GetStoryReturns(result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.getStoryMutex.Lock()
+	defer fake.getStoryMutex.Unlock()
+	fake.GetStoryStub = nil
+	fake.getStoryReturns = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetStoryReturnsOnCall(i int, result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.getStoryMutex.Lock()
+	defer fake.getStoryMutex.Unlock()
+	fake.GetStoryStub = nil
+	if fake.getStoryReturnsOnCall == nil {
+		fake.getStoryReturnsOnCall = make(map[int]struct {
+			result1 *pt.Story
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getStoryReturnsOnCall[i] = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetTask(arg1 int, arg2 int, arg3 int) (*pt.Task, *http.Response, error) {
+	fake.getTaskMutex.Lock()
+	ret, specificReturn := fake.getTaskReturnsOnCall[len(fake.getTaskArgsForCall)]
+	fake.getTaskArgsForCall = append(fake.getTaskArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetTask", []interface{}{arg1, arg2, arg3})
+	fake.getTaskMutex.Unlock()
+	if fake.GetTaskStub != nil {
+		return fake.GetTaskStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getTaskReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetTaskCallCount() int {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return len(fake.getTaskArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetTaskCalls(stub func(int, int, int) (*pt.Task, *http.Response, error)) {
+	fake.getTaskMutex.Lock()
+	defer fake.getTaskMutex.Unlock()
+	fake.GetTaskStub = stub
+}
+
+func (fake *FakeClientCaller) GetTaskArgsForCall(i int) (int, int, int) {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	argsForCall := fake.getTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) GetTaskReturns(result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.getTaskMutex.Lock()
+	defer fake.getTaskMutex.Unlock()
+	fake.GetTaskStub = nil
+	fake.getTaskReturns = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetTaskReturnsOnCall(i int, result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.getTaskMutex.Lock()
+	defer fake.getTaskMutex.Unlock()
+	fake.GetTaskStub = nil
+	if fake.getTaskReturnsOnCall == nil {
+		fake.getTaskReturnsOnCall = make(map[int]struct {
+			result1 *pt.Task
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getTaskReturnsOnCall[i] = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetWebhook(arg1 int, arg2 int) (*pt.Webhook, *http.Response, error) {
+	fake.getWebhookMutex.Lock()
+	ret, specificReturn := fake.getWebhookReturnsOnCall[len(fake.getWebhookArgsForCall)]
+	fake.getWebhookArgsForCall = append(fake.getWebhookArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetWebhook", []interface{}{arg1, arg2})
+	fake.getWebhookMutex.Unlock()
+	if fake.GetWebhookStub != nil {
+		return fake.GetWebhookStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetWebhookCallCount() int {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	return len(fake.getWebhookArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetWebhookCalls(stub func(int, int) (*pt.Webhook, *http.Response, error)) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = stub
+}
+
+func (fake *FakeClientCaller) GetWebhookArgsForCall(i int) (int, int) {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	argsForCall := fake.getWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) GetWebhookReturns(result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	fake.getWebhookReturns = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetWebhookReturnsOnCall(i int, result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	if fake.getWebhookReturnsOnCall == nil {
+		fake.getWebhookReturnsOnCall = make(map[int]struct {
+			result1 *pt.Webhook
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getWebhookReturnsOnCall[i] = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetWorkspace(arg1 int) (*pt.Workspace, *http.Response, error) {
+	fake.getWorkspaceMutex.Lock()
+	ret, specificReturn := fake.getWorkspaceReturnsOnCall[len(fake.getWorkspaceArgsForCall)]
+	fake.getWorkspaceArgsForCall = append(fake.getWorkspaceArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("GetWorkspace", []interface{}{arg1})
+	fake.getWorkspaceMutex.Unlock()
+	if fake.GetWorkspaceStub != nil {
+		return fake.GetWorkspaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getWorkspaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) GetWorkspaceCallCount() int {
+	fake.getWorkspaceMutex.RLock()
+	defer fake.getWorkspaceMutex.RUnlock()
+	return len(fake.getWorkspaceArgsForCall)
+}
+
+func (fake *FakeClientCaller) GetWorkspaceCalls(stub func(int) (*pt.Workspace, *http.Response, error)) {
+	fake.getWorkspaceMutex.Lock()
+	defer fake.getWorkspaceMutex.Unlock()
+	fake.GetWorkspaceStub = stub
+}
+
+func (fake *FakeClientCaller) GetWorkspaceArgsForCall(i int) int {
+	fake.getWorkspaceMutex.RLock()
+	defer fake.getWorkspaceMutex.RUnlock()
+	argsForCall := fake.getWorkspaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) GetWorkspaceReturns(result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.getWorkspaceMutex.Lock()
+	defer fake.getWorkspaceMutex.Unlock()
+	fake.GetWorkspaceStub = nil
+	fake.getWorkspaceReturns = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) GetWorkspaceReturnsOnCall(i int, result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.getWorkspaceMutex.Lock()
+	defer fake.getWorkspaceMutex.Unlock()
+	fake.GetWorkspaceStub = nil
+	if fake.getWorkspaceReturnsOnCall == nil {
+		fake.getWorkspaceReturnsOnCall = make(map[int]struct {
+			result1 *pt.Workspace
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getWorkspaceReturnsOnCall[i] = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListAccountMembers(arg1 int) ([]pt.AccountMember, *http.Response, error) {
+	fake.listAccountMembersMutex.Lock()
+	ret, specificReturn := fake.listAccountMembersReturnsOnCall[len(fake.listAccountMembersArgsForCall)]
+	fake.listAccountMembersArgsForCall = append(fake.listAccountMembersArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListAccountMembers", []interface{}{arg1})
+	fake.listAccountMembersMutex.Unlock()
+	if fake.ListAccountMembersStub != nil {
+		return fake.ListAccountMembersStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listAccountMembersReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListAccountMembersCallCount() int {
+	fake.listAccountMembersMutex.RLock()
+	defer fake.listAccountMembersMutex.RUnlock()
+	return len(fake.listAccountMembersArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListAccountMembersCalls(stub func(int) ([]pt.AccountMember, *http.Response, error)) {
+	fake.listAccountMembersMutex.Lock()
+	defer fake.listAccountMembersMutex.Unlock()
+	fake.ListAccountMembersStub = stub
+}
+
+func (fake *FakeClientCaller) ListAccountMembersArgsForCall(i int) int {
+	fake.listAccountMembersMutex.RLock()
+	defer fake.listAccountMembersMutex.RUnlock()
+	argsForCall := fake.listAccountMembersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) ListAccountMembersReturns(result1 []pt.AccountMember, result2 *http.Response, result3 error) {
+	fake.listAccountMembersMutex.Lock()
+	defer fake.listAccountMembersMutex.Unlock()
+	fake.ListAccountMembersStub = nil
+	fake.listAccountMembersReturns = struct {
+		result1 []pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListAccountMembersReturnsOnCall(i int, result1 []pt.AccountMember, result2 *http.Response, result3 error) {
+	fake.listAccountMembersMutex.Lock()
+	defer fake.listAccountMembersMutex.Unlock()
+	fake.ListAccountMembersStub = nil
+	if fake.listAccountMembersReturnsOnCall == nil {
+		fake.listAccountMembersReturnsOnCall = make(map[int]struct {
+			result1 []pt.AccountMember
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listAccountMembersReturnsOnCall[i] = struct {
+		result1 []pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListAccounts() ([]pt.Account, *http.Response, error) {
+	fake.listAccountsMutex.Lock()
+	ret, specificReturn := fake.listAccountsReturnsOnCall[len(fake.listAccountsArgsForCall)]
+	fake.listAccountsArgsForCall = append(fake.listAccountsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListAccounts", []interface{}{})
+	fake.listAccountsMutex.Unlock()
+	if fake.ListAccountsStub != nil {
+		return fake.ListAccountsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listAccountsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListAccountsCallCount() int {
+	fake.listAccountsMutex.RLock()
+	defer fake.listAccountsMutex.RUnlock()
+	return len(fake.listAccountsArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListAccountsCalls(stub func() ([]pt.Account, *http.Response, error)) {
+	fake.listAccountsMutex.Lock()
+	defer fake.listAccountsMutex.Unlock()
+	fake.ListAccountsStub = stub
+}
+
+func (fake *FakeClientCaller) ListAccountsReturns(result1 []pt.Account, result2 *http.Response, result3 error) {
+	fake.listAccountsMutex.Lock()
+	defer fake.listAccountsMutex.Unlock()
+	fake.ListAccountsStub = nil
+	fake.listAccountsReturns = struct {
+		result1 []pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListAccountsReturnsOnCall(i int, result1 []pt.Account, result2 *http.Response, result3 error) {
+	fake.listAccountsMutex.Lock()
+	defer fake.listAccountsMutex.Unlock()
+	fake.ListAccountsStub = nil
+	if fake.listAccountsReturnsOnCall == nil {
+		fake.listAccountsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Account
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listAccountsReturnsOnCall[i] = struct {
+		result1 []pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListEpics(arg1 int) ([]pt.Epic, *http.Response, error) {
+	fake.listEpicsMutex.Lock()
+	ret, specificReturn := fake.listEpicsReturnsOnCall[len(fake.listEpicsArgsForCall)]
+	fake.listEpicsArgsForCall = append(fake.listEpicsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListEpics", []interface{}{arg1})
+	fake.listEpicsMutex.Unlock()
+	if fake.ListEpicsStub != nil {
+		return fake.ListEpicsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listEpicsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListEpicsCallCount() int {
+	fake.listEpicsMutex.RLock()
+	defer fake.listEpicsMutex.RUnlock()
+	return len(fake.listEpicsArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListEpicsCalls(stub func(int) ([]pt.Epic, *http.Response, error)) {
+	fake.listEpicsMutex.Lock()
+	defer fake.listEpicsMutex.Unlock()
+	fake.ListEpicsStub = stub
+}
+
+func (fake *FakeClientCaller) ListEpicsArgsForCall(i int) int {
+	fake.listEpicsMutex.RLock()
+	defer fake.listEpicsMutex.RUnlock()
+	argsForCall := fake.listEpicsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) ListEpicsReturns(result1 []pt.Epic, result2 *http.Response, result3 error) {
+	fake.listEpicsMutex.Lock()
+	defer fake.listEpicsMutex.Unlock()
+	fake.ListEpicsStub = nil
+	fake.listEpicsReturns = struct {
+		result1 []pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListEpicsReturnsOnCall(i int, result1 []pt.Epic, result2 *http.Response, result3 error) {
+	fake.listEpicsMutex.Lock()
+	defer fake.listEpicsMutex.Unlock()
+	fake.ListEpicsStub = nil
+	if fake.listEpicsReturnsOnCall == nil {
+		fake.listEpicsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Epic
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listEpicsReturnsOnCall[i] = struct {
+		result1 []pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListIntegrations(arg1 int) ([]pt.Integration, *http.Response, error) {
+	fake.listIntegrationsMutex.Lock()
+	ret, specificReturn := fake.listIntegrationsReturnsOnCall[len(fake.listIntegrationsArgsForCall)]
+	fake.listIntegrationsArgsForCall = append(fake.listIntegrationsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListIntegrations", []interface{}{arg1})
+	fake.listIntegrationsMutex.Unlock()
+	if fake.ListIntegrationsStub != nil {
+		return fake.ListIntegrationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listIntegrationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListIntegrationsCallCount() int {
+	fake.listIntegrationsMutex.RLock()
+	defer fake.listIntegrationsMutex.RUnlock()
+	return len(fake.listIntegrationsArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListIntegrationsCalls(stub func(int) ([]pt.Integration, *http.Response, error)) {
+	fake.listIntegrationsMutex.Lock()
+	defer fake.listIntegrationsMutex.Unlock()
+	fake.ListIntegrationsStub = stub
+}
+
+func (fake *FakeClientCaller) ListIntegrationsArgsForCall(i int) int {
+	fake.listIntegrationsMutex.RLock()
+	defer fake.listIntegrationsMutex.RUnlock()
+	argsForCall := fake.listIntegrationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) ListIntegrationsReturns(result1 []pt.Integration, result2 *http.Response, result3 error) {
+	fake.listIntegrationsMutex.Lock()
+	defer fake.listIntegrationsMutex.Unlock()
+	fake.ListIntegrationsStub = nil
+	fake.listIntegrationsReturns = struct {
+		result1 []pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListIntegrationsReturnsOnCall(i int, result1 []pt.Integration, result2 *http.Response, result3 error) {
+	fake.listIntegrationsMutex.Lock()
+	defer fake.listIntegrationsMutex.Unlock()
+	fake.ListIntegrationsStub = nil
+	if fake.listIntegrationsReturnsOnCall == nil {
+		fake.listIntegrationsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Integration
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listIntegrationsReturnsOnCall[i] = struct {
+		result1 []pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListIterationOverrides(arg1 int) ([]pt.IterationOverride, *http.Response, error) {
+	fake.listIterationOverridesMutex.Lock()
+	ret, specificReturn := fake.listIterationOverridesReturnsOnCall[len(fake.listIterationOverridesArgsForCall)]
+	fake.listIterationOverridesArgsForCall = append(fake.listIterationOverridesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListIterationOverrides", []interface{}{arg1})
+	fake.listIterationOverridesMutex.Unlock()
+	if fake.ListIterationOverridesStub != nil {
+		return fake.ListIterationOverridesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listIterationOverridesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListIterationOverridesCallCount() int {
+	fake.listIterationOverridesMutex.RLock()
+	defer fake.listIterationOverridesMutex.RUnlock()
+	return len(fake.listIterationOverridesArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListIterationOverridesCalls(stub func(int) ([]pt.IterationOverride, *http.Response, error)) {
+	fake.listIterationOverridesMutex.Lock()
+	defer fake.listIterationOverridesMutex.Unlock()
+	fake.ListIterationOverridesStub = stub
+}
+
+func (fake *FakeClientCaller) ListIterationOverridesArgsForCall(i int) int {
+	fake.listIterationOverridesMutex.RLock()
+	defer fake.listIterationOverridesMutex.RUnlock()
+	argsForCall := fake.listIterationOverridesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) ListIterationOverridesReturns(result1 []pt.IterationOverride, result2 *http.Response, result3 error) {
+	fake.listIterationOverridesMutex.Lock()
+	defer fake.listIterationOverridesMutex.Unlock()
+	fake.ListIterationOverridesStub = nil
+	fake.listIterationOverridesReturns = struct {
+		result1 []pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListIterationOverridesReturnsOnCall(i int, result1 []pt.IterationOverride, result2 *http.Response, result3 error) {
+	fake.listIterationOverridesMutex.Lock()
+	defer fake.listIterationOverridesMutex.Unlock()
+	fake.ListIterationOverridesStub = nil
+	if fake.listIterationOverridesReturnsOnCall == nil {
+		fake.listIterationOverridesReturnsOnCall = make(map[int]struct {
+			result1 []pt.IterationOverride
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listIterationOverridesReturnsOnCall[i] = struct {
+		result1 []pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListLabels(arg1 int) ([]pt.Label, *http.Response, error) {
+	fake.listLabelsMutex.Lock()
+	ret, specificReturn := fake.listLabelsReturnsOnCall[len(fake.listLabelsArgsForCall)]
+	fake.listLabelsArgsForCall = append(fake.listLabelsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListLabels", []interface{}{arg1})
+	fake.listLabelsMutex.Unlock()
+	if fake.ListLabelsStub != nil {
+		return fake.ListLabelsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listLabelsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListLabelsCallCount() int {
+	fake.listLabelsMutex.RLock()
+	defer fake.listLabelsMutex.RUnlock()
+	return len(fake.listLabelsArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListLabelsCalls(stub func(int) ([]pt.Label, *http.Response, error)) {
+	fake.listLabelsMutex.Lock()
+	defer fake.listLabelsMutex.Unlock()
+	fake.ListLabelsStub = stub
+}
+
+func (fake *FakeClientCaller) ListLabelsArgsForCall(i int) int {
+	fake.listLabelsMutex.RLock()
+	defer fake.listLabelsMutex.RUnlock()
+	argsForCall := fake.listLabelsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) ListLabelsReturns(result1 []pt.Label, result2 *http.Response, result3 error) {
+	fake.listLabelsMutex.Lock()
+	defer fake.listLabelsMutex.Unlock()
+	fake.ListLabelsStub = nil
+	fake.listLabelsReturns = struct {
+		result1 []pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListLabelsReturnsOnCall(i int, result1 []pt.Label, result2 *http.Response, result3 error) {
+	fake.listLabelsMutex.Lock()
+	defer fake.listLabelsMutex.Unlock()
+	fake.ListLabelsStub = nil
+	if fake.listLabelsReturnsOnCall == nil {
+		fake.listLabelsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Label
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listLabelsReturnsOnCall[i] = struct {
+		result1 []pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListProjectMemberships(arg1 int) ([]pt.ProjectMembershipResponse, *http.Response, error) {
+	fake.listProjectMembershipsMutex.Lock()
+	ret, specificReturn := fake.listProjectMembershipsReturnsOnCall[len(fake.listProjectMembershipsArgsForCall)]
+	fake.listProjectMembershipsArgsForCall = append(fake.listProjectMembershipsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListProjectMemberships", []interface{}{arg1})
+	fake.listProjectMembershipsMutex.Unlock()
+	if fake.ListProjectMembershipsStub != nil {
+		return fake.ListProjectMembershipsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listProjectMembershipsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListProjectMembershipsCallCount() int {
+	fake.listProjectMembershipsMutex.RLock()
+	defer fake.listProjectMembershipsMutex.RUnlock()
+	return len(fake.listProjectMembershipsArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListProjectMembershipsCalls(stub func(int) ([]pt.ProjectMembershipResponse, *http.Response, error)) {
+	fake.listProjectMembershipsMutex.Lock()
+	defer fake.listProjectMembershipsMutex.Unlock()
+	fake.ListProjectMembershipsStub = stub
+}
+
+func (fake *FakeClientCaller) ListProjectMembershipsArgsForCall(i int) int {
+	fake.listProjectMembershipsMutex.RLock()
+	defer fake.listProjectMembershipsMutex.RUnlock()
+	argsForCall := fake.listProjectMembershipsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) ListProjectMembershipsReturns(result1 []pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.listProjectMembershipsMutex.Lock()
+	defer fake.listProjectMembershipsMutex.Unlock()
+	fake.ListProjectMembershipsStub = nil
+	fake.listProjectMembershipsReturns = struct {
+		result1 []pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListProjectMembershipsReturnsOnCall(i int, result1 []pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.listProjectMembershipsMutex.Lock()
+	defer fake.listProjectMembershipsMutex.Unlock()
+	fake.ListProjectMembershipsStub = nil
+	if fake.listProjectMembershipsReturnsOnCall == nil {
+		fake.listProjectMembershipsReturnsOnCall = make(map[int]struct {
+			result1 []pt.ProjectMembershipResponse
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listProjectMembershipsReturnsOnCall[i] = struct {
+		result1 []pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListProjects() ([]*pt.Project, *http.Response, error) {
+	fake.listProjectsMutex.Lock()
+	ret, specificReturn := fake.listProjectsReturnsOnCall[len(fake.listProjectsArgsForCall)]
+	fake.listProjectsArgsForCall = append(fake.listProjectsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListProjects", []interface{}{})
+	fake.listProjectsMutex.Unlock()
+	if fake.ListProjectsStub != nil {
+		return fake.ListProjectsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listProjectsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListProjectsCallCount() int {
+	fake.listProjectsMutex.RLock()
+	defer fake.listProjectsMutex.RUnlock()
+	return len(fake.listProjectsArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListProjectsCalls(stub func() ([]*pt.Project, *http.Response, error)) {
+	fake.listProjectsMutex.Lock()
+	defer fake.listProjectsMutex.Unlock()
+	fake.ListProjectsStub = stub
+}
+
+func (fake *FakeClientCaller) ListProjectsReturns(result1 []*pt.Project, result2 *http.Response, result3 error) {
+	fake.listProjectsMutex.Lock()
+	defer fake.listProjectsMutex.Unlock()
+	fake.ListProjectsStub = nil
+	fake.listProjectsReturns = struct {
+		result1 []*pt.Project
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListProjectsReturnsOnCall(i int, result1 []*pt.Project, result2 *http.Response, result3 error) {
+	fake.listProjectsMutex.Lock()
+	defer fake.listProjectsMutex.Unlock()
+	fake.ListProjectsStub = nil
+	if fake.listProjectsReturnsOnCall == nil {
+		fake.listProjectsReturnsOnCall = make(map[int]struct {
+			result1 []*pt.Project
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listProjectsReturnsOnCall[i] = struct {
+		result1 []*pt.Project
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListReviewTypes(arg1 int) ([]pt.ReviewType, *http.Response, error) {
+	fake.listReviewTypesMutex.Lock()
+	ret, specificReturn := fake.listReviewTypesReturnsOnCall[len(fake.listReviewTypesArgsForCall)]
+	fake.listReviewTypesArgsForCall = append(fake.listReviewTypesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListReviewTypes", []interface{}{arg1})
+	fake.listReviewTypesMutex.Unlock()
+	if fake.ListReviewTypesStub != nil {
+		return fake.ListReviewTypesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listReviewTypesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListReviewTypesCallCount() int {
+	fake.listReviewTypesMutex.RLock()
+	defer fake.listReviewTypesMutex.RUnlock()
+	return len(fake.listReviewTypesArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListReviewTypesCalls(stub func(int) ([]pt.ReviewType, *http.Response, error)) {
+	fake.listReviewTypesMutex.Lock()
+	defer fake.listReviewTypesMutex.Unlock()
+	fake.ListReviewTypesStub = stub
+}
+
+func (fake *FakeClientCaller) ListReviewTypesArgsForCall(i int) int {
+	fake.listReviewTypesMutex.RLock()
+	defer fake.listReviewTypesMutex.RUnlock()
+	argsForCall := fake.listReviewTypesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) ListReviewTypesReturns(result1 []pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.listReviewTypesMutex.Lock()
+	defer fake.listReviewTypesMutex.Unlock()
+	fake.ListReviewTypesStub = nil
+	fake.listReviewTypesReturns = struct {
+		result1 []pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListReviewTypesReturnsOnCall(i int, result1 []pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.listReviewTypesMutex.Lock()
+	defer fake.listReviewTypesMutex.Unlock()
+	fake.ListReviewTypesStub = nil
+	if fake.listReviewTypesReturnsOnCall == nil {
+		fake.listReviewTypesReturnsOnCall = make(map[int]struct {
+			result1 []pt.ReviewType
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listReviewTypesReturnsOnCall[i] = struct {
+		result1 []pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListReviews(arg1 int, arg2 int) ([]pt.Review, *http.Response, error) {
+	fake.listReviewsMutex.Lock()
+	ret, specificReturn := fake.listReviewsReturnsOnCall[len(fake.listReviewsArgsForCall)]
+	fake.listReviewsArgsForCall = append(fake.listReviewsArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("ListReviews", []interface{}{arg1, arg2})
+	fake.listReviewsMutex.Unlock()
+	if fake.ListReviewsStub != nil {
+		return fake.ListReviewsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listReviewsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListReviewsCallCount() int {
+	fake.listReviewsMutex.RLock()
+	defer fake.listReviewsMutex.RUnlock()
+	return len(fake.listReviewsArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListReviewsCalls(stub func(int, int) ([]pt.Review, *http.Response, error)) {
+	fake.listReviewsMutex.Lock()
+	defer fake.listReviewsMutex.Unlock()
+	fake.ListReviewsStub = stub
+}
+
+func (fake *FakeClientCaller) ListReviewsArgsForCall(i int) (int, int) {
+	fake.listReviewsMutex.RLock()
+	defer fake.listReviewsMutex.RUnlock()
+	argsForCall := fake.listReviewsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) ListReviewsReturns(result1 []pt.Review, result2 *http.Response, result3 error) {
+	fake.listReviewsMutex.Lock()
+	defer fake.listReviewsMutex.Unlock()
+	fake.ListReviewsStub = nil
+	fake.listReviewsReturns = struct {
+		result1 []pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListReviewsReturnsOnCall(i int, result1 []pt.Review, result2 *http.Response, result3 error) {
+	fake.listReviewsMutex.Lock()
+	defer fake.listReviewsMutex.Unlock()
+	fake.ListReviewsStub = nil
+	if fake.listReviewsReturnsOnCall == nil {
+		fake.listReviewsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Review
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listReviewsReturnsOnCall[i] = struct {
+		result1 []pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListStories(arg1 int) ([]pt.Story, *http.Response, error) {
+	fake.listStoriesMutex.Lock()
+	ret, specificReturn := fake.listStoriesReturnsOnCall[len(fake.listStoriesArgsForCall)]
+	fake.listStoriesArgsForCall = append(fake.listStoriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListStories", []interface{}{arg1})
+	fake.listStoriesMutex.Unlock()
+	if fake.ListStoriesStub != nil {
+		return fake.ListStoriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listStoriesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListStoriesCallCount() int {
+	fake.listStoriesMutex.RLock()
+	defer fake.listStoriesMutex.RUnlock()
+	return len(fake.listStoriesArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListStoriesCalls(stub func(int) ([]pt.Story, *http.Response, error)) {
+	fake.listStoriesMutex.Lock()
+	defer fake.listStoriesMutex.Unlock()
+	fake.ListStoriesStub = stub
+}
+
+func (fake *FakeClientCaller) ListStoriesArgsForCall(i int) int {
+	fake.listStoriesMutex.RLock()
+	defer fake.listStoriesMutex.RUnlock()
+	argsForCall := fake.listStoriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) ListStoriesReturns(result1 []pt.Story, result2 *http.Response, result3 error) {
+	fake.listStoriesMutex.Lock()
+	defer fake.listStoriesMutex.Unlock()
+	fake.ListStoriesStub = nil
+	fake.listStoriesReturns = struct {
+		result1 []pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListStoriesReturnsOnCall(i int, result1 []pt.Story, result2 *http.Response, result3 error) {
+	fake.listStoriesMutex.Lock()
+	defer fake.listStoriesMutex.Unlock()
+	fake.ListStoriesStub = nil
+	if fake.listStoriesReturnsOnCall == nil {
+		fake.listStoriesReturnsOnCall = make(map[int]struct {
+			result1 []pt.Story
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listStoriesReturnsOnCall[i] = struct {
+		result1 []pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListTasks(arg1 int, arg2 int) ([]pt.Task, *http.Response, error) {
+	fake.listTasksMutex.Lock()
+	ret, specificReturn := fake.listTasksReturnsOnCall[len(fake.listTasksArgsForCall)]
+	fake.listTasksArgsForCall = append(fake.listTasksArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("ListTasks", []interface{}{arg1, arg2})
+	fake.listTasksMutex.Unlock()
+	if fake.ListTasksStub != nil {
+		return fake.ListTasksStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listTasksReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListTasksCallCount() int {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	return len(fake.listTasksArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListTasksCalls(stub func(int, int) ([]pt.Task, *http.Response, error)) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = stub
+}
+
+func (fake *FakeClientCaller) ListTasksArgsForCall(i int) (int, int) {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	argsForCall := fake.listTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) ListTasksReturns(result1 []pt.Task, result2 *http.Response, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	fake.listTasksReturns = struct {
+		result1 []pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListTasksReturnsOnCall(i int, result1 []pt.Task, result2 *http.Response, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	if fake.listTasksReturnsOnCall == nil {
+		fake.listTasksReturnsOnCall = make(map[int]struct {
+			result1 []pt.Task
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listTasksReturnsOnCall[i] = struct {
+		result1 []pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListWebhooks(arg1 int) ([]pt.Webhook, *http.Response, error) {
+	fake.listWebhooksMutex.Lock()
+	ret, specificReturn := fake.listWebhooksReturnsOnCall[len(fake.listWebhooksArgsForCall)]
+	fake.listWebhooksArgsForCall = append(fake.listWebhooksArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListWebhooks", []interface{}{arg1})
+	fake.listWebhooksMutex.Unlock()
+	if fake.ListWebhooksStub != nil {
+		return fake.ListWebhooksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listWebhooksReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListWebhooksCallCount() int {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	return len(fake.listWebhooksArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListWebhooksCalls(stub func(int) ([]pt.Webhook, *http.Response, error)) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = stub
+}
+
+func (fake *FakeClientCaller) ListWebhooksArgsForCall(i int) int {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	argsForCall := fake.listWebhooksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) ListWebhooksReturns(result1 []pt.Webhook, result2 *http.Response, result3 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	fake.listWebhooksReturns = struct {
+		result1 []pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListWebhooksReturnsOnCall(i int, result1 []pt.Webhook, result2 *http.Response, result3 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	if fake.listWebhooksReturnsOnCall == nil {
+		fake.listWebhooksReturnsOnCall = make(map[int]struct {
+			result1 []pt.Webhook
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listWebhooksReturnsOnCall[i] = struct {
+		result1 []pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListWorkspaces() ([]pt.Workspace, *http.Response, error) {
+	fake.listWorkspacesMutex.Lock()
+	ret, specificReturn := fake.listWorkspacesReturnsOnCall[len(fake.listWorkspacesArgsForCall)]
+	fake.listWorkspacesArgsForCall = append(fake.listWorkspacesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListWorkspaces", []interface{}{})
+	fake.listWorkspacesMutex.Unlock()
+	if fake.ListWorkspacesStub != nil {
+		return fake.ListWorkspacesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listWorkspacesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) ListWorkspacesCallCount() int {
+	fake.listWorkspacesMutex.RLock()
+	defer fake.listWorkspacesMutex.RUnlock()
+	return len(fake.listWorkspacesArgsForCall)
+}
+
+func (fake *FakeClientCaller) ListWorkspacesCalls(stub func() ([]pt.Workspace, *http.Response, error)) {
+	fake.listWorkspacesMutex.Lock()
+	defer fake.listWorkspacesMutex.Unlock()
+	fake.ListWorkspacesStub = stub
+}
+
+func (fake *FakeClientCaller) ListWorkspacesReturns(result1 []pt.Workspace, result2 *http.Response, result3 error) {
+	fake.listWorkspacesMutex.Lock()
+	defer fake.listWorkspacesMutex.Unlock()
+	fake.ListWorkspacesStub = nil
+	fake.listWorkspacesReturns = struct {
+		result1 []pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) ListWorkspacesReturnsOnCall(i int, result1 []pt.Workspace, result2 *http.Response, result3 error) {
+	fake.listWorkspacesMutex.Lock()
+	defer fake.listWorkspacesMutex.Unlock()
+	fake.ListWorkspacesStub = nil
+	if fake.listWorkspacesReturnsOnCall == nil {
+		fake.listWorkspacesReturnsOnCall = make(map[int]struct {
+			result1 []pt.Workspace
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listWorkspacesReturnsOnCall[i] = struct {
+		result1 []pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewAccountMember(arg1 int, arg2 pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error) {
+	fake.newAccountMemberMutex.Lock()
+	ret, specificReturn := fake.newAccountMemberReturnsOnCall[len(fake.newAccountMemberArgsForCall)]
+	fake.newAccountMemberArgsForCall = append(fake.newAccountMemberArgsForCall, struct {
+		arg1 int
+		arg2 pt.AccountMemberRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewAccountMember", []interface{}{arg1, arg2})
+	fake.newAccountMemberMutex.Unlock()
+	if fake.NewAccountMemberStub != nil {
+		return fake.NewAccountMemberStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newAccountMemberReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewAccountMemberCallCount() int {
+	fake.newAccountMemberMutex.RLock()
+	defer fake.newAccountMemberMutex.RUnlock()
+	return len(fake.newAccountMemberArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewAccountMemberCalls(stub func(int, pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error)) {
+	fake.newAccountMemberMutex.Lock()
+	defer fake.newAccountMemberMutex.Unlock()
+	fake.NewAccountMemberStub = stub
+}
+
+func (fake *FakeClientCaller) NewAccountMemberArgsForCall(i int) (int, pt.AccountMemberRequest) {
+	fake.newAccountMemberMutex.RLock()
+	defer fake.newAccountMemberMutex.RUnlock()
+	argsForCall := fake.newAccountMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) NewAccountMemberReturns(result1 *pt.AccountMember, result2 *http.Response, result3 error) {
+	fake.newAccountMemberMutex.Lock()
+	defer fake.newAccountMemberMutex.Unlock()
+	fake.NewAccountMemberStub = nil
+	fake.newAccountMemberReturns = struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewAccountMemberReturnsOnCall(i int, result1 *pt.AccountMember, result2 *http.Response, result3 error) {
+	fake.newAccountMemberMutex.Lock()
+	defer fake.newAccountMemberMutex.Unlock()
+	fake.NewAccountMemberStub = nil
+	if fake.newAccountMemberReturnsOnCall == nil {
+		fake.newAccountMemberReturnsOnCall = make(map[int]struct {
+			result1 *pt.AccountMember
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newAccountMemberReturnsOnCall[i] = struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewEpic(arg1 int, arg2 pt.EpicRequest) (*pt.Epic, *http.Response, error) {
+	fake.newEpicMutex.Lock()
+	ret, specificReturn := fake.newEpicReturnsOnCall[len(fake.newEpicArgsForCall)]
+	fake.newEpicArgsForCall = append(fake.newEpicArgsForCall, struct {
+		arg1 int
+		arg2 pt.EpicRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewEpic", []interface{}{arg1, arg2})
+	fake.newEpicMutex.Unlock()
+	if fake.NewEpicStub != nil {
+		return fake.NewEpicStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newEpicReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewEpicCallCount() int {
+	fake.newEpicMutex.RLock()
+	defer fake.newEpicMutex.RUnlock()
+	return len(fake.newEpicArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewEpicCalls(stub func(int, pt.EpicRequest) (*pt.Epic, *http.Response, error)) {
+	fake.newEpicMutex.Lock()
+	defer fake.newEpicMutex.Unlock()
+	fake.NewEpicStub = stub
+}
+
+func (fake *FakeClientCaller) NewEpicArgsForCall(i int) (int, pt.EpicRequest) {
+	fake.newEpicMutex.RLock()
+	defer fake.newEpicMutex.RUnlock()
+	argsForCall := fake.newEpicArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) NewEpicReturns(result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.newEpicMutex.Lock()
+	defer fake.newEpicMutex.Unlock()
+	fake.NewEpicStub = nil
+	fake.newEpicReturns = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewEpicReturnsOnCall(i int, result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.newEpicMutex.Lock()
+	defer fake.newEpicMutex.Unlock()
+	fake.NewEpicStub = nil
+	if fake.newEpicReturnsOnCall == nil {
+		fake.newEpicReturnsOnCall = make(map[int]struct {
+			result1 *pt.Epic
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newEpicReturnsOnCall[i] = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewIntegration(arg1 int, arg2 pt.IntegrationRequest) (*pt.Integration, *http.Response, error) {
+	fake.newIntegrationMutex.Lock()
+	ret, specificReturn := fake.newIntegrationReturnsOnCall[len(fake.newIntegrationArgsForCall)]
+	fake.newIntegrationArgsForCall = append(fake.newIntegrationArgsForCall, struct {
+		arg1 int
+		arg2 pt.IntegrationRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewIntegration", []interface{}{arg1, arg2})
+	fake.newIntegrationMutex.Unlock()
+	if fake.NewIntegrationStub != nil {
+		return fake.NewIntegrationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newIntegrationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewIntegrationCallCount() int {
+	fake.newIntegrationMutex.RLock()
+	defer fake.newIntegrationMutex.RUnlock()
+	return len(fake.newIntegrationArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewIntegrationCalls(stub func(int, pt.IntegrationRequest) (*pt.Integration, *http.Response, error)) {
+	fake.newIntegrationMutex.Lock()
+	defer fake.newIntegrationMutex.Unlock()
+	fake.NewIntegrationStub = stub
+}
+
+func (fake *FakeClientCaller) NewIntegrationArgsForCall(i int) (int, pt.IntegrationRequest) {
+	fake.newIntegrationMutex.RLock()
+	defer fake.newIntegrationMutex.RUnlock()
+	argsForCall := fake.newIntegrationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) NewIntegrationReturns(result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.newIntegrationMutex.Lock()
+	defer fake.newIntegrationMutex.Unlock()
+	fake.NewIntegrationStub = nil
+	fake.newIntegrationReturns = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewIntegrationReturnsOnCall(i int, result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.newIntegrationMutex.Lock()
+	defer fake.newIntegrationMutex.Unlock()
+	fake.NewIntegrationStub = nil
+	if fake.newIntegrationReturnsOnCall == nil {
+		fake.newIntegrationReturnsOnCall = make(map[int]struct {
+			result1 *pt.Integration
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newIntegrationReturnsOnCall[i] = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewLabel(arg1 int, arg2 pt.LabelRequest) (*pt.Label, *http.Response, error) {
+	fake.newLabelMutex.Lock()
+	ret, specificReturn := fake.newLabelReturnsOnCall[len(fake.newLabelArgsForCall)]
+	fake.newLabelArgsForCall = append(fake.newLabelArgsForCall, struct {
+		arg1 int
+		arg2 pt.LabelRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewLabel", []interface{}{arg1, arg2})
+	fake.newLabelMutex.Unlock()
+	if fake.NewLabelStub != nil {
+		return fake.NewLabelStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newLabelReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewLabelCallCount() int {
+	fake.newLabelMutex.RLock()
+	defer fake.newLabelMutex.RUnlock()
+	return len(fake.newLabelArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewLabelCalls(stub func(int, pt.LabelRequest) (*pt.Label, *http.Response, error)) {
+	fake.newLabelMutex.Lock()
+	defer fake.newLabelMutex.Unlock()
+	fake.NewLabelStub = stub
+}
+
+func (fake *FakeClientCaller) NewLabelArgsForCall(i int) (int, pt.LabelRequest) {
+	fake.newLabelMutex.RLock()
+	defer fake.newLabelMutex.RUnlock()
+	argsForCall := fake.newLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) NewLabelReturns(result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.newLabelMutex.Lock()
+	defer fake.newLabelMutex.Unlock()
+	fake.NewLabelStub = nil
+	fake.newLabelReturns = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewLabelReturnsOnCall(i int, result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.newLabelMutex.Lock()
+	defer fake.newLabelMutex.Unlock()
+	fake.NewLabelStub = nil
+	if fake.newLabelReturnsOnCall == nil {
+		fake.newLabelReturnsOnCall = make(map[int]struct {
+			result1 *pt.Label
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newLabelReturnsOnCall[i] = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewProject(arg1 pt.ProjectsRequest) (*pt.Project, *http.Response, error) {
+	fake.newProjectMutex.Lock()
+	ret, specificReturn := fake.newProjectReturnsOnCall[len(fake.newProjectArgsForCall)]
+	fake.newProjectArgsForCall = append(fake.newProjectArgsForCall, struct {
+		arg1 pt.ProjectsRequest
+	}{arg1})
+	fake.recordInvocation("NewProject", []interface{}{arg1})
+	fake.newProjectMutex.Unlock()
+	if fake.NewProjectStub != nil {
+		return fake.NewProjectStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newProjectReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewProjectCallCount() int {
+	fake.newProjectMutex.RLock()
+	defer fake.newProjectMutex.RUnlock()
+	return len(fake.newProjectArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewProjectCalls(stub func(pt.ProjectsRequest) (*pt.Project, *http.Response, error)) {
+	fake.newProjectMutex.Lock()
+	defer fake.newProjectMutex.Unlock()
+	fake.NewProjectStub = stub
+}
+
+func (fake *FakeClientCaller) NewProjectArgsForCall(i int) pt.ProjectsRequest {
+	fake.newProjectMutex.RLock()
+	defer fake.newProjectMutex.RUnlock()
+	argsForCall := fake.newProjectArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) NewProjectReturns(result1 *pt.Project, result2 *http.Response, result3 error) {
+	fake.newProjectMutex.Lock()
+	defer fake.newProjectMutex.Unlock()
+	fake.NewProjectStub = nil
+	fake.newProjectReturns = struct {
+		result1 *pt.Project
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewProjectReturnsOnCall(i int, result1 *pt.Project, result2 *http.Response, result3 error) {
+	fake.newProjectMutex.Lock()
+	defer fake.newProjectMutex.Unlock()
+	fake.NewProjectStub = nil
+	if fake.newProjectReturnsOnCall == nil {
+		fake.newProjectReturnsOnCall = make(map[int]struct {
+			result1 *pt.Project
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newProjectReturnsOnCall[i] = struct {
+		result1 *pt.Project
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewProjectMembership(arg1 int, arg2 pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error) {
+	fake.newProjectMembershipMutex.Lock()
+	ret, specificReturn := fake.newProjectMembershipReturnsOnCall[len(fake.newProjectMembershipArgsForCall)]
+	fake.newProjectMembershipArgsForCall = append(fake.newProjectMembershipArgsForCall, struct {
+		arg1 int
+		arg2 pt.ProjectMembershipRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewProjectMembership", []interface{}{arg1, arg2})
+	fake.newProjectMembershipMutex.Unlock()
+	if fake.NewProjectMembershipStub != nil {
+		return fake.NewProjectMembershipStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newProjectMembershipReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewProjectMembershipCallCount() int {
+	fake.newProjectMembershipMutex.RLock()
+	defer fake.newProjectMembershipMutex.RUnlock()
+	return len(fake.newProjectMembershipArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewProjectMembershipCalls(stub func(int, pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error)) {
+	fake.newProjectMembershipMutex.Lock()
+	defer fake.newProjectMembershipMutex.Unlock()
+	fake.NewProjectMembershipStub = stub
+}
+
+func (fake *FakeClientCaller) NewProjectMembershipArgsForCall(i int) (int, pt.ProjectMembershipRequest) {
+	fake.newProjectMembershipMutex.RLock()
+	defer fake.newProjectMembershipMutex.RUnlock()
+	argsForCall := fake.newProjectMembershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) NewProjectMembershipReturns(result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.newProjectMembershipMutex.Lock()
+	defer fake.newProjectMembershipMutex.Unlock()
+	fake.NewProjectMembershipStub = nil
+	fake.newProjectMembershipReturns = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewProjectMembershipReturnsOnCall(i int, result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.newProjectMembershipMutex.Lock()
+	defer fake.newProjectMembershipMutex.Unlock()
+	fake.NewProjectMembershipStub = nil
+	if fake.newProjectMembershipReturnsOnCall == nil {
+		fake.newProjectMembershipReturnsOnCall = make(map[int]struct {
+			result1 *pt.ProjectMembershipResponse
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newProjectMembershipReturnsOnCall[i] = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewReview(arg1 int, arg2 int, arg3 pt.ReviewRequest) (*pt.Review, *http.Response, error) {
+	fake.newReviewMutex.Lock()
+	ret, specificReturn := fake.newReviewReturnsOnCall[len(fake.newReviewArgsForCall)]
+	fake.newReviewArgsForCall = append(fake.newReviewArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ReviewRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("NewReview", []interface{}{arg1, arg2, arg3})
+	fake.newReviewMutex.Unlock()
+	if fake.NewReviewStub != nil {
+		return fake.NewReviewStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newReviewReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewReviewCallCount() int {
+	fake.newReviewMutex.RLock()
+	defer fake.newReviewMutex.RUnlock()
+	return len(fake.newReviewArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewReviewCalls(stub func(int, int, pt.ReviewRequest) (*pt.Review, *http.Response, error)) {
+	fake.newReviewMutex.Lock()
+	defer fake.newReviewMutex.Unlock()
+	fake.NewReviewStub = stub
+}
+
+func (fake *FakeClientCaller) NewReviewArgsForCall(i int) (int, int, pt.ReviewRequest) {
+	fake.newReviewMutex.RLock()
+	defer fake.newReviewMutex.RUnlock()
+	argsForCall := fake.newReviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) NewReviewReturns(result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.newReviewMutex.Lock()
+	defer fake.newReviewMutex.Unlock()
+	fake.NewReviewStub = nil
+	fake.newReviewReturns = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewReviewReturnsOnCall(i int, result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.newReviewMutex.Lock()
+	defer fake.newReviewMutex.Unlock()
+	fake.NewReviewStub = nil
+	if fake.newReviewReturnsOnCall == nil {
+		fake.newReviewReturnsOnCall = make(map[int]struct {
+			result1 *pt.Review
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newReviewReturnsOnCall[i] = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewReviewType(arg1 int, arg2 pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error) {
+	fake.newReviewTypeMutex.Lock()
+	ret, specificReturn := fake.newReviewTypeReturnsOnCall[len(fake.newReviewTypeArgsForCall)]
+	fake.newReviewTypeArgsForCall = append(fake.newReviewTypeArgsForCall, struct {
+		arg1 int
+		arg2 pt.ReviewTypeRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewReviewType", []interface{}{arg1, arg2})
+	fake.newReviewTypeMutex.Unlock()
+	if fake.NewReviewTypeStub != nil {
+		return fake.NewReviewTypeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newReviewTypeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewReviewTypeCallCount() int {
+	fake.newReviewTypeMutex.RLock()
+	defer fake.newReviewTypeMutex.RUnlock()
+	return len(fake.newReviewTypeArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewReviewTypeCalls(stub func(int, pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error)) {
+	fake.newReviewTypeMutex.Lock()
+	defer fake.newReviewTypeMutex.Unlock()
+	fake.NewReviewTypeStub = stub
+}
+
+func (fake *FakeClientCaller) NewReviewTypeArgsForCall(i int) (int, pt.ReviewTypeRequest) {
+	fake.newReviewTypeMutex.RLock()
+	defer fake.newReviewTypeMutex.RUnlock()
+	argsForCall := fake.newReviewTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) NewReviewTypeReturns(result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.newReviewTypeMutex.Lock()
+	defer fake.newReviewTypeMutex.Unlock()
+	fake.NewReviewTypeStub = nil
+	fake.newReviewTypeReturns = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewReviewTypeReturnsOnCall(i int, result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.newReviewTypeMutex.Lock()
+	defer fake.newReviewTypeMutex.Unlock()
+	fake.NewReviewTypeStub = nil
+	if fake.newReviewTypeReturnsOnCall == nil {
+		fake.newReviewTypeReturnsOnCall = make(map[int]struct {
+			result1 *pt.ReviewType
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newReviewTypeReturnsOnCall[i] = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewStory(arg1 int, arg2 pt.StoryRequest) (*pt.Story, *http.Response, error) {
+	fake.newStoryMutex.Lock()
+	ret, specificReturn := fake.newStoryReturnsOnCall[len(fake.newStoryArgsForCall)]
+	fake.newStoryArgsForCall = append(fake.newStoryArgsForCall, struct {
+		arg1 int
+		arg2 pt.StoryRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewStory", []interface{}{arg1, arg2})
+	fake.newStoryMutex.Unlock()
+	if fake.NewStoryStub != nil {
+		return fake.NewStoryStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newStoryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewStoryCallCount() int {
+	fake.newStoryMutex.RLock()
+	defer fake.newStoryMutex.RUnlock()
+	return len(fake.newStoryArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewStoryCalls(stub func(int, pt.StoryRequest) (*pt.Story, *http.Response, error)) {
+	fake.newStoryMutex.Lock()
+	defer fake.newStoryMutex.Unlock()
+	fake.NewStoryStub = stub
+}
+
+func (fake *FakeClientCaller) NewStoryArgsForCall(i int) (int, pt.StoryRequest) {
+	fake.newStoryMutex.RLock()
+	defer fake.newStoryMutex.RUnlock()
+	argsForCall := fake.newStoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) NewStoryReturns(result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.newStoryMutex.Lock()
+	defer fake.newStoryMutex.Unlock()
+	fake.NewStoryStub = nil
+	fake.newStoryReturns = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewStoryReturnsOnCall(i int, result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.newStoryMutex.Lock()
+	defer fake.newStoryMutex.Unlock()
+	fake.NewStoryStub = nil
+	if fake.newStoryReturnsOnCall == nil {
+		fake.newStoryReturnsOnCall = make(map[int]struct {
+			result1 *pt.Story
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newStoryReturnsOnCall[i] = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewTask(arg1 int, arg2 int, arg3 pt.TaskRequest) (*pt.Task, *http.Response, error) {
+	fake.newTaskMutex.Lock()
+	ret, specificReturn := fake.newTaskReturnsOnCall[len(fake.newTaskArgsForCall)]
+	fake.newTaskArgsForCall = append(fake.newTaskArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.TaskRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("NewTask", []interface{}{arg1, arg2, arg3})
+	fake.newTaskMutex.Unlock()
+	if fake.NewTaskStub != nil {
+		return fake.NewTaskStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newTaskReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewTaskCallCount() int {
+	fake.newTaskMutex.RLock()
+	defer fake.newTaskMutex.RUnlock()
+	return len(fake.newTaskArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewTaskCalls(stub func(int, int, pt.TaskRequest) (*pt.Task, *http.Response, error)) {
+	fake.newTaskMutex.Lock()
+	defer fake.newTaskMutex.Unlock()
+	fake.NewTaskStub = stub
+}
+
+func (fake *FakeClientCaller) NewTaskArgsForCall(i int) (int, int, pt.TaskRequest) {
+	fake.newTaskMutex.RLock()
+	defer fake.newTaskMutex.RUnlock()
+	argsForCall := fake.newTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) NewTaskReturns(result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.newTaskMutex.Lock()
+	defer fake.newTaskMutex.Unlock()
+	fake.NewTaskStub = nil
+	fake.newTaskReturns = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewTaskReturnsOnCall(i int, result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.newTaskMutex.Lock()
+	defer fake.newTaskMutex.Unlock()
+	fake.NewTaskStub = nil
+	if fake.newTaskReturnsOnCall == nil {
+		fake.newTaskReturnsOnCall = make(map[int]struct {
+			result1 *pt.Task
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newTaskReturnsOnCall[i] = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewWebhook(arg1 int, arg2 pt.WebhookRequest) (*pt.Webhook, *http.Response, error) {
+	fake.newWebhookMutex.Lock()
+	ret, specificReturn := fake.newWebhookReturnsOnCall[len(fake.newWebhookArgsForCall)]
+	fake.newWebhookArgsForCall = append(fake.newWebhookArgsForCall, struct {
+		arg1 int
+		arg2 pt.WebhookRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewWebhook", []interface{}{arg1, arg2})
+	fake.newWebhookMutex.Unlock()
+	if fake.NewWebhookStub != nil {
+		return fake.NewWebhookStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewWebhookCallCount() int {
+	fake.newWebhookMutex.RLock()
+	defer fake.newWebhookMutex.RUnlock()
+	return len(fake.newWebhookArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewWebhookCalls(stub func(int, pt.WebhookRequest) (*pt.Webhook, *http.Response, error)) {
+	fake.newWebhookMutex.Lock()
+	defer fake.newWebhookMutex.Unlock()
+	fake.NewWebhookStub = stub
+}
+
+func (fake *FakeClientCaller) NewWebhookArgsForCall(i int) (int, pt.WebhookRequest) {
+	fake.newWebhookMutex.RLock()
+	defer fake.newWebhookMutex.RUnlock()
+	argsForCall := fake.newWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) NewWebhookReturns(result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.newWebhookMutex.Lock()
+	defer fake.newWebhookMutex.Unlock()
+	fake.NewWebhookStub = nil
+	fake.newWebhookReturns = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewWebhookReturnsOnCall(i int, result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.newWebhookMutex.Lock()
+	defer fake.newWebhookMutex.Unlock()
+	fake.NewWebhookStub = nil
+	if fake.newWebhookReturnsOnCall == nil {
+		fake.newWebhookReturnsOnCall = make(map[int]struct {
+			result1 *pt.Webhook
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newWebhookReturnsOnCall[i] = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewWorkspace(arg1 pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error) {
+	fake.newWorkspaceMutex.Lock()
+	ret, specificReturn := fake.newWorkspaceReturnsOnCall[len(fake.newWorkspaceArgsForCall)]
+	fake.newWorkspaceArgsForCall = append(fake.newWorkspaceArgsForCall, struct {
+		arg1 pt.WorkspaceRequest
+	}{arg1})
+	fake.recordInvocation("NewWorkspace", []interface{}{arg1})
+	fake.newWorkspaceMutex.Unlock()
+	if fake.NewWorkspaceStub != nil {
+		return fake.NewWorkspaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newWorkspaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) NewWorkspaceCallCount() int {
+	fake.newWorkspaceMutex.RLock()
+	defer fake.newWorkspaceMutex.RUnlock()
+	return len(fake.newWorkspaceArgsForCall)
+}
+
+func (fake *FakeClientCaller) NewWorkspaceCalls(stub func(pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error)) {
+	fake.newWorkspaceMutex.Lock()
+	defer fake.newWorkspaceMutex.Unlock()
+	fake.NewWorkspaceStub = stub
+}
+
+func (fake *FakeClientCaller) NewWorkspaceArgsForCall(i int) pt.WorkspaceRequest {
+	fake.newWorkspaceMutex.RLock()
+	defer fake.newWorkspaceMutex.RUnlock()
+	argsForCall := fake.newWorkspaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClientCaller) NewWorkspaceReturns(result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.newWorkspaceMutex.Lock()
+	defer fake.newWorkspaceMutex.Unlock()
+	fake.NewWorkspaceStub = nil
+	fake.newWorkspaceReturns = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) NewWorkspaceReturnsOnCall(i int, result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.newWorkspaceMutex.Lock()
+	defer fake.newWorkspaceMutex.Unlock()
+	fake.NewWorkspaceStub = nil
+	if fake.newWorkspaceReturnsOnCall == nil {
+		fake.newWorkspaceReturnsOnCall = make(map[int]struct {
+			result1 *pt.Workspace
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newWorkspaceReturnsOnCall[i] = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) UpdateAccount(arg1 int, arg2 pt.AccountRequest) (*pt.Account, *http.Response, error) {
+	fake.updateAccountMutex.Lock()
+	ret, specificReturn := fake.updateAccountReturnsOnCall[len(fake.updateAccountArgsForCall)]
+	fake.updateAccountArgsForCall = append(fake.updateAccountArgsForCall, struct {
+		arg1 int
+		arg2 pt.AccountRequest
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateAccount", []interface{}{arg1, arg2})
+	fake.updateAccountMutex.Unlock()
+	if fake.UpdateAccountStub != nil {
+		return fake.UpdateAccountStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateAccountReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) UpdateAccountCallCount() int {
+	fake.updateAccountMutex.RLock()
+	defer fake.updateAccountMutex.RUnlock()
+	return len(fake.updateAccountArgsForCall)
+}
+
+func (fake *FakeClientCaller) UpdateAccountCalls(stub func(int, pt.AccountRequest) (*pt.Account, *http.Response, error)) {
+	fake.updateAccountMutex.Lock()
+	defer fake.updateAccountMutex.Unlock()
+	fake.UpdateAccountStub = stub
+}
+
+func (fake *FakeClientCaller) UpdateAccountArgsForCall(i int) (int, pt.AccountRequest) {
+	fake.updateAccountMutex.RLock()
+	defer fake.updateAccountMutex.RUnlock()
+	argsForCall := fake.updateAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClientCaller) UpdateAccountReturns(result1 *pt.Account, result2 *http.Response, result3 error) {
+	fake.updateAccountMutex.Lock()
+	defer fake.updateAccountMutex.Unlock()
+	fake.UpdateAccountStub = nil
+	fake.updateAccountReturns = struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) UpdateAccountReturnsOnCall(i int, result1 *pt.Account, result2 *http.Response, result3 error) {
+	fake.updateAccountMutex.Lock()
+	defer fake.updateAccountMutex.Unlock()
+	fake.UpdateAccountStub = nil
+	if fake.updateAccountReturnsOnCall == nil {
+		fake.updateAccountReturnsOnCall = make(map[int]struct {
+			result1 *pt.Account
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateAccountReturnsOnCall[i] = struct {
+		result1 *pt.Account
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) UpdateAccountMember(arg1 int, arg2 int, arg3 pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error) {
+	fake.updateAccountMemberMutex.Lock()
+	ret, specificReturn := fake.updateAccountMemberReturnsOnCall[len(fake.updateAccountMemberArgsForCall)]
+	fake.updateAccountMemberArgsForCall = append(fake.updateAccountMemberArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.AccountMemberRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateAccountMember", []interface{}{arg1, arg2, arg3})
+	fake.updateAccountMemberMutex.Unlock()
+	if fake.UpdateAccountMemberStub != nil {
+		return fake.UpdateAccountMemberStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateAccountMemberReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) UpdateAccountMemberCallCount() int {
+	fake.updateAccountMemberMutex.RLock()
+	defer fake.updateAccountMemberMutex.RUnlock()
+	return len(fake.updateAccountMemberArgsForCall)
+}
+
+func (fake *FakeClientCaller) UpdateAccountMemberCalls(stub func(int, int, pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error)) {
+	fake.updateAccountMemberMutex.Lock()
+	defer fake.updateAccountMemberMutex.Unlock()
+	fake.UpdateAccountMemberStub = stub
+}
+
+func (fake *FakeClientCaller) UpdateAccountMemberArgsForCall(i int) (int, int, pt.AccountMemberRequest) {
+	fake.updateAccountMemberMutex.RLock()
+	defer fake.updateAccountMemberMutex.RUnlock()
+	argsForCall := fake.updateAccountMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) UpdateAccountMemberReturns(result1 *pt.AccountMember, result2 *http.Response, result3 error) {
+	fake.updateAccountMemberMutex.Lock()
+	defer fake.updateAccountMemberMutex.Unlock()
+	fake.UpdateAccountMemberStub = nil
+	fake.updateAccountMemberReturns = struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) UpdateAccountMemberReturnsOnCall(i int, result1 *pt.AccountMember, result2 *http.Response, result3 error) {
+	fake.updateAccountMemberMutex.Lock()
+	defer fake.updateAccountMemberMutex.Unlock()
+	fake.UpdateAccountMemberStub = nil
+	if fake.updateAccountMemberReturnsOnCall == nil {
+		fake.updateAccountMemberReturnsOnCall = make(map[int]struct {
+			result1 *pt.AccountMember
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateAccountMemberReturnsOnCall[i] = struct {
+		result1 *pt.AccountMember
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) UpdateEpic(arg1 int, arg2 int, arg3 pt.EpicRequest) (*pt.Epic, *http.Response, error) {
+	fake.updateEpicMutex.Lock()
+	ret, specificReturn := fake.updateEpicReturnsOnCall[len(fake.updateEpicArgsForCall)]
+	fake.updateEpicArgsForCall = append(fake.updateEpicArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.EpicRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateEpic", []interface{}{arg1, arg2, arg3})
+	fake.updateEpicMutex.Unlock()
+	if fake.UpdateEpicStub != nil {
+		return fake.UpdateEpicStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateEpicReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) UpdateEpicCallCount() int {
+	fake.updateEpicMutex.RLock()
+	defer fake.updateEpicMutex.RUnlock()
+	return len(fake.updateEpicArgsForCall)
+}
+
+func (fake *FakeClientCaller) UpdateEpicCalls(stub func(int, int, pt.EpicRequest) (*pt.Epic, *http.Response, error)) {
+	fake.updateEpicMutex.Lock()
+	defer fake.updateEpicMutex.Unlock()
+	fake.UpdateEpicStub = stub
+}
+
+func (fake *FakeClientCaller) UpdateEpicArgsForCall(i int) (int, int, pt.EpicRequest) {
+	fake.updateEpicMutex.RLock()
+	defer fake.updateEpicMutex.RUnlock()
+	argsForCall := fake.updateEpicArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) UpdateEpicReturns(result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.updateEpicMutex.Lock()
+	defer fake.updateEpicMutex.Unlock()
+	fake.UpdateEpicStub = nil
+	fake.updateEpicReturns = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) UpdateEpicReturnsOnCall(i int, result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.updateEpicMutex.Lock()
+	defer fake.updateEpicMutex.Unlock()
+	fake.UpdateEpicStub = nil
+	if fake.updateEpicReturnsOnCall == nil {
+		fake.updateEpicReturnsOnCall = make(map[int]struct {
+			result1 *pt.Epic
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateEpicReturnsOnCall[i] = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) UpdateIntegration(arg1 int, arg2 int, arg3 pt.IntegrationRequest) (*pt.Integration, *http.Response, error) {
+	fake.updateIntegrationMutex.Lock()
+	ret, specificReturn := fake.updateIntegrationReturnsOnCall[len(fake.updateIntegrationArgsForCall)]
+	fake.updateIntegrationArgsForCall = append(fake.updateIntegrationArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.IntegrationRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateIntegration", []interface{}{arg1, arg2, arg3})
+	fake.updateIntegrationMutex.Unlock()
+	if fake.UpdateIntegrationStub != nil {
+		return fake.UpdateIntegrationStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.deleteAccountMemberReturns
-	return fakeReturns.result1, fakeReturns.result2
+	fakeReturns := fake.updateIntegrationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) DeleteAccountMemberCallCount() int {
-	fake.deleteAccountMemberMutex.RLock()
-	defer fake.deleteAccountMemberMutex.RUnlock()
-	return len(fake.deleteAccountMemberArgsForCall)
+func (fake *FakeClientCaller) UpdateIntegrationCallCount() int {
+	fake.updateIntegrationMutex.RLock()
+	defer fake.updateIntegrationMutex.RUnlock()
+	return len(fake.updateIntegrationArgsForCall)
 }
 
-func (fake *FakeClientCaller) DeleteAccountMemberCalls(stub func(int, int) (*http.Response, error)) {
-	fake.deleteAccountMemberMutex.Lock()
-	defer fake.deleteAccountMemberMutex.Unlock()
-	fake.DeleteAccountMemberStub = stub
+func (fake *FakeClientCaller) UpdateIntegrationCalls(stub func(int, int, pt.IntegrationRequest) (*pt.Integration, *http.Response, error)) {
+	fake.updateIntegrationMutex.Lock()
+	defer fake.updateIntegrationMutex.Unlock()
+	fake.UpdateIntegrationStub = stub
 }
 
-func (fake *FakeClientCaller) DeleteAccountMemberArgsForCall(i int) (int, int) {
-	fake.deleteAccountMemberMutex.RLock()
-	defer fake.deleteAccountMemberMutex.RUnlock()
-	argsForCall := fake.deleteAccountMemberArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeClientCaller) UpdateIntegrationArgsForCall(i int) (int, int, pt.IntegrationRequest) {
+	fake.updateIntegrationMutex.RLock()
+	defer fake.updateIntegrationMutex.RUnlock()
+	argsForCall := fake.updateIntegrationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeClientCaller) DeleteAccountMemberReturns(result1 *http.Response, result2 error) {
-	fake.deleteAccountMemberMutex.Lock()
-	defer fake.deleteAccountMemberMutex.Unlock()
-	fake.DeleteAccountMemberStub = nil
-	fake.deleteAccountMemberReturns = struct {
-		result1 *http.Response
-		result2 error
-	}{result1, result2}
+func (fake *FakeClientCaller) UpdateIntegrationReturns(result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.updateIntegrationMutex.Lock()
+	defer fake.updateIntegrationMutex.Unlock()
+	fake.UpdateIntegrationStub = nil
+	fake.updateIntegrationReturns = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) DeleteAccountMemberReturnsOnCall(i int, result1 *http.Response, result2 error) {
-	fake.deleteAccountMemberMutex.Lock()
-	defer fake.deleteAccountMemberMutex.Unlock()
-	fake.DeleteAccountMemberStub = nil
-	if fake.deleteAccountMemberReturnsOnCall == nil {
-		fake.deleteAccountMemberReturnsOnCall = make(map[int]struct {
-			result1 *http.Response
-			result2 error
+func (fake *FakeClientCaller) UpdateIntegrationReturnsOnCall(i int, result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.updateIntegrationMutex.Lock()
+	defer fake.updateIntegrationMutex.Unlock()
+	fake.UpdateIntegrationStub = nil
+	if fake.updateIntegrationReturnsOnCall == nil {
+		fake.updateIntegrationReturnsOnCall = make(map[int]struct {
+			result1 *pt.Integration
+			result2 *http.Response
+			result3 error
 		})
 	}
-	fake.deleteAccountMemberReturnsOnCall[i] = struct {
-		result1 *http.Response
-		result2 error
-	}{result1, result2}
+	fake.updateIntegrationReturnsOnCall[i] = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) DeleteProject(arg1 int) (*http.Response, error) {
-	fake.deleteProjectMutex.Lock()
-	ret, specificReturn := fake.deleteProjectReturnsOnCall[len(fake.deleteProjectArgsForCall)]
-	fake.deleteProjectArgsForCall = append(fake.deleteProjectArgsForCall, struct {
+func (fake *FakeClientCaller) UpdateIterationOverride(arg1 int, arg2 int, arg3 pt.IterationOverrideRequest) (*pt.IterationOverride, *http.Response, error) {
+	fake.updateIterationOverrideMutex.Lock()
+	ret, specificReturn := fake.updateIterationOverrideReturnsOnCall[len(fake.updateIterationOverrideArgsForCall)]
+	fake.updateIterationOverrideArgsForCall = append(fake.updateIterationOverrideArgsForCall, struct {
 		arg1 int
-	}{arg1})
-	fake.recordInvocation("DeleteProject", []interface{}{arg1})
-	fake.deleteProjectMutex.Unlock()
-	if fake.DeleteProjectStub != nil {
-		return fake.DeleteProjectStub(arg1)
+		arg2 int
+		arg3 pt.IterationOverrideRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateIterationOverride", []interface{}{arg1, arg2, arg3})
+	fake.updateIterationOverrideMutex.Unlock()
+	if fake.UpdateIterationOverrideStub != nil {
+		return fake.UpdateIterationOverrideStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.deleteProjectReturns
-	return fakeReturns.result1, fakeReturns.result2
+	fakeReturns := fake.updateIterationOverrideReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) DeleteProjectCallCount() int {
-	fake.deleteProjectMutex.RLock()
-	defer fake.deleteProjectMutex.RUnlock()
-	return len(fake.deleteProjectArgsForCall)
+func (fake *FakeClientCaller) UpdateIterationOverrideCallCount() int {
+	fake.updateIterationOverrideMutex.RLock()
+	defer fake.updateIterationOverrideMutex.RUnlock()
+	return len(fake.updateIterationOverrideArgsForCall)
 }
 
-func (fake *FakeClientCaller) DeleteProjectCalls(stub func(int) (*http.Response, error)) {
-	fake.deleteProjectMutex.Lock()
-	defer fake.deleteProjectMutex.Unlock()
-	fake.DeleteProjectStub = stub
+func (fake *FakeClientCaller) UpdateIterationOverrideCalls(stub func(int, int, pt.IterationOverrideRequest) (*pt.IterationOverride, *http.Response, error)) {
+	fake.updateIterationOverrideMutex.Lock()
+	defer fake.updateIterationOverrideMutex.Unlock()
+	fake.UpdateIterationOverrideStub = stub
 }
 
-func (fake *FakeClientCaller) DeleteProjectArgsForCall(i int) int {
-	fake.deleteProjectMutex.RLock()
-	defer fake.deleteProjectMutex.RUnlock()
-	argsForCall := fake.deleteProjectArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeClientCaller) UpdateIterationOverrideArgsForCall(i int) (int, int, pt.IterationOverrideRequest) {
+	fake.updateIterationOverrideMutex.RLock()
+	defer fake.updateIterationOverrideMutex.RUnlock()
+	argsForCall := fake.updateIterationOverrideArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeClientCaller) DeleteProjectReturns(result1 *http.Response, result2 error) {
-	fake.deleteProjectMutex.Lock()
-	defer fake.deleteProjectMutex.Unlock()
-	fake.DeleteProjectStub = nil
-	fake.deleteProjectReturns = struct {
-		result1 *http.Response
-		result2 error
-	}{result1, result2}
+func (fake *FakeClientCaller) UpdateIterationOverrideReturns(result1 *pt.IterationOverride, result2 *http.Response, result3 error) {
+	fake.updateIterationOverrideMutex.Lock()
+	defer fake.updateIterationOverrideMutex.Unlock()
+	fake.UpdateIterationOverrideStub = nil
+	fake.updateIterationOverrideReturns = struct {
+		result1 *pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) DeleteProjectReturnsOnCall(i int, result1 *http.Response, result2 error) {
-	fake.deleteProjectMutex.Lock()
-	defer fake.deleteProjectMutex.Unlock()
-	fake.DeleteProjectStub = nil
-	if fake.deleteProjectReturnsOnCall == nil {
-		fake.deleteProjectReturnsOnCall = make(map[int]struct {
-			result1 *http.Response
-			result2 error
+func (fake *FakeClientCaller) UpdateIterationOverrideReturnsOnCall(i int, result1 *pt.IterationOverride, result2 *http.Response, result3 error) {
+	fake.updateIterationOverrideMutex.Lock()
+	defer fake.updateIterationOverrideMutex.Unlock()
+	fake.UpdateIterationOverrideStub = nil
+	if fake.updateIterationOverrideReturnsOnCall == nil {
+		fake.updateIterationOverrideReturnsOnCall = make(map[int]struct {
+			result1 *pt.IterationOverride
+			result2 *http.Response
+			result3 error
 		})
 	}
-	fake.deleteProjectReturnsOnCall[i] = struct {
-		result1 *http.Response
-		result2 error
-	}{result1, result2}
+	fake.updateIterationOverrideReturnsOnCall[i] = struct {
+		result1 *pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) GetAccountMember(arg1 int, arg2 int) (*pt.AccountMember, *http.Response, error) {
-	fake.getAccountMemberMutex.Lock()
-	ret, specificReturn := fake.getAccountMemberReturnsOnCall[len(fake.getAccountMemberArgsForCall)]
-	fake.getAccountMemberArgsForCall = append(fake.getAccountMemberArgsForCall, struct {
+func (fake *FakeClientCaller) UpdateLabel(arg1 int, arg2 int, arg3 pt.LabelRequest) (*pt.Label, *http.Response, error) {
+	fake.updateLabelMutex.Lock()
+	ret, specificReturn := fake.updateLabelReturnsOnCall[len(fake.updateLabelArgsForCall)]
+	fake.updateLabelArgsForCall = append(fake.updateLabelArgsForCall, struct {
 		arg1 int
 		arg2 int
+		arg3 pt.LabelRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateLabel", []interface{}{arg1, arg2, arg3})
+	fake.updateLabelMutex.Unlock()
+	if fake.UpdateLabelStub != nil {
+		return fake.UpdateLabelStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateLabelReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClientCaller) UpdateLabelCallCount() int {
+	fake.updateLabelMutex.RLock()
+	defer fake.updateLabelMutex.RUnlock()
+	return len(fake.updateLabelArgsForCall)
+}
+
+func (fake *FakeClientCaller) UpdateLabelCalls(stub func(int, int, pt.LabelRequest) (*pt.Label, *http.Response, error)) {
+	fake.updateLabelMutex.Lock()
+	defer fake.updateLabelMutex.Unlock()
+	fake.UpdateLabelStub = stub
+}
+
+func (fake *FakeClientCaller) UpdateLabelArgsForCall(i int) (int, int, pt.LabelRequest) {
+	fake.updateLabelMutex.RLock()
+	defer fake.updateLabelMutex.RUnlock()
+	argsForCall := fake.updateLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) UpdateLabelReturns(result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.updateLabelMutex.Lock()
+	defer fake.updateLabelMutex.Unlock()
+	fake.UpdateLabelStub = nil
+	fake.updateLabelReturns = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) UpdateLabelReturnsOnCall(i int, result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.updateLabelMutex.Lock()
+	defer fake.updateLabelMutex.Unlock()
+	fake.UpdateLabelStub = nil
+	if fake.updateLabelReturnsOnCall == nil {
+		fake.updateLabelReturnsOnCall = make(map[int]struct {
+			result1 *pt.Label
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateLabelReturnsOnCall[i] = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClientCaller) UpdateProject(arg1 int, arg2 pt.ProjectRequest) (*pt.Project, *http.Response, error) {
+	fake.updateProjectMutex.Lock()
+	ret, specificReturn := fake.updateProjectReturnsOnCall[len(fake.updateProjectArgsForCall)]
+	fake.updateProjectArgsForCall = append(fake.updateProjectArgsForCall, struct {
+		arg1 int
+		arg2 pt.ProjectRequest
 	}{arg1, arg2})
-	fake.recordInvocation("GetAccountMember", []interface{}{arg1, arg2})
-	fake.getAccountMemberMutex.Unlock()
-	if fake.GetAccountMemberStub != nil {
-		return fake.GetAccountMemberStub(arg1, arg2)
+	fake.recordInvocation("UpdateProject", []interface{}{arg1, arg2})
+	fake.updateProjectMutex.Unlock()
+	if fake.UpdateProjectStub != nil {
+		return fake.UpdateProjectStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.getAccountMemberReturns
+	fakeReturns := fake.updateProjectReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) GetAccountMemberCallCount() int {
-	fake.getAccountMemberMutex.RLock()
-	defer fake.getAccountMemberMutex.RUnlock()
-	return len(fake.getAccountMemberArgsForCall)
+func (fake *FakeClientCaller) UpdateProjectCallCount() int {
+	fake.updateProjectMutex.RLock()
+	defer fake.updateProjectMutex.RUnlock()
+	return len(fake.updateProjectArgsForCall)
 }
 
-func (fake *FakeClientCaller) GetAccountMemberCalls(stub func(int, int) (*pt.AccountMember, *http.Response, error)) {
-	fake.getAccountMemberMutex.Lock()
-	defer fake.getAccountMemberMutex.Unlock()
-	fake.GetAccountMemberStub = stub
+func (fake *FakeClientCaller) UpdateProjectCalls(stub func(int, pt.ProjectRequest) (*pt.Project, *http.Response, error)) {
+	fake.updateProjectMutex.Lock()
+	defer fake.updateProjectMutex.Unlock()
+	fake.UpdateProjectStub = stub
 }
 
-func (fake *FakeClientCaller) GetAccountMemberArgsForCall(i int) (int, int) {
-	fake.getAccountMemberMutex.RLock()
-	defer fake.getAccountMemberMutex.RUnlock()
-	argsForCall := fake.getAccountMemberArgsForCall[i]
+func (fake *FakeClientCaller) UpdateProjectArgsForCall(i int) (int, pt.ProjectRequest) {
+	fake.updateProjectMutex.RLock()
+	defer fake.updateProjectMutex.RUnlock()
+	argsForCall := fake.updateProjectArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeClientCaller) GetAccountMemberReturns(result1 *pt.AccountMember, result2 *http.Response, result3 error) {
-	fake.getAccountMemberMutex.Lock()
-	defer fake.getAccountMemberMutex.Unlock()
-	fake.GetAccountMemberStub = nil
-	fake.getAccountMemberReturns = struct {
-		result1 *pt.AccountMember
+func (fake *FakeClientCaller) UpdateProjectReturns(result1 *pt.Project, result2 *http.Response, result3 error) {
+	fake.updateProjectMutex.Lock()
+	defer fake.updateProjectMutex.Unlock()
+	fake.UpdateProjectStub = nil
+	fake.updateProjectReturns = struct {
+		result1 *pt.Project
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) GetAccountMemberReturnsOnCall(i int, result1 *pt.AccountMember, result2 *http.Response, result3 error) {
-	fake.getAccountMemberMutex.Lock()
-	defer fake.getAccountMemberMutex.Unlock()
-	fake.GetAccountMemberStub = nil
-	if fake.getAccountMemberReturnsOnCall == nil {
-		fake.getAccountMemberReturnsOnCall = make(map[int]struct {
-			result1 *pt.AccountMember
+func (fake *FakeClientCaller) UpdateProjectReturnsOnCall(i int, result1 *pt.Project, result2 *http.Response, result3 error) {
+	fake.updateProjectMutex.Lock()
+	defer fake.updateProjectMutex.Unlock()
+	fake.UpdateProjectStub = nil
+	if fake.updateProjectReturnsOnCall == nil {
+		fake.updateProjectReturnsOnCall = make(map[int]struct {
+			result1 *pt.Project
 			result2 *http.Response
 			result3 error
 		})
 	}
-	fake.getAccountMemberReturnsOnCall[i] = struct {
-		result1 *pt.AccountMember
+	fake.updateProjectReturnsOnCall[i] = struct {
+		result1 *pt.Project
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) GetProject(arg1 int) (*pt.Project, *http.Response, error) {
-	fake.getProjectMutex.Lock()
-	ret, specificReturn := fake.getProjectReturnsOnCall[len(fake.getProjectArgsForCall)]
-	fake.getProjectArgsForCall = append(fake.getProjectArgsForCall, struct {
+func (fake *FakeClientCaller) UpdateProjectMembership(arg1 int, arg2 int, arg3 pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error) {
+	fake.updateProjectMembershipMutex.Lock()
+	ret, specificReturn := fake.updateProjectMembershipReturnsOnCall[len(fake.updateProjectMembershipArgsForCall)]
+	fake.updateProjectMembershipArgsForCall = append(fake.updateProjectMembershipArgsForCall, struct {
 		arg1 int
-	}{arg1})
-	fake.recordInvocation("GetProject", []interface{}{arg1})
-	fake.getProjectMutex.Unlock()
-	if fake.GetProjectStub != nil {
-		return fake.GetProjectStub(arg1)
+		arg2 int
+		arg3 pt.ProjectMembershipRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateProjectMembership", []interface{}{arg1, arg2, arg3})
+	fake.updateProjectMembershipMutex.Unlock()
+	if fake.UpdateProjectMembershipStub != nil {
+		return fake.UpdateProjectMembershipStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.getProjectReturns
+	fakeReturns := fake.updateProjectMembershipReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) GetProjectCallCount() int {
-	fake.getProjectMutex.RLock()
-	defer fake.getProjectMutex.RUnlock()
-	return len(fake.getProjectArgsForCall)
+func (fake *FakeClientCaller) UpdateProjectMembershipCallCount() int {
+	fake.updateProjectMembershipMutex.RLock()
+	defer fake.updateProjectMembershipMutex.RUnlock()
+	return len(fake.updateProjectMembershipArgsForCall)
 }
 
-func (fake *FakeClientCaller) GetProjectCalls(stub func(int) (*pt.Project, *http.Response, error)) {
-	fake.getProjectMutex.Lock()
-	defer fake.getProjectMutex.Unlock()
-	fake.GetProjectStub = stub
+func (fake *FakeClientCaller) UpdateProjectMembershipCalls(stub func(int, int, pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error)) {
+	fake.updateProjectMembershipMutex.Lock()
+	defer fake.updateProjectMembershipMutex.Unlock()
+	fake.UpdateProjectMembershipStub = stub
 }
 
-func (fake *FakeClientCaller) GetProjectArgsForCall(i int) int {
-	fake.getProjectMutex.RLock()
-	defer fake.getProjectMutex.RUnlock()
-	argsForCall := fake.getProjectArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeClientCaller) UpdateProjectMembershipArgsForCall(i int) (int, int, pt.ProjectMembershipRequest) {
+	fake.updateProjectMembershipMutex.RLock()
+	defer fake.updateProjectMembershipMutex.RUnlock()
+	argsForCall := fake.updateProjectMembershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeClientCaller) GetProjectReturns(result1 *pt.Project, result2 *http.Response, result3 error) {
-	fake.getProjectMutex.Lock()
-	defer fake.getProjectMutex.Unlock()
-	fake.GetProjectStub = nil
-	fake.getProjectReturns = struct {
-		result1 *pt.Project
+func (fake *FakeClientCaller) UpdateProjectMembershipReturns(result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.updateProjectMembershipMutex.Lock()
+	defer fake.updateProjectMembershipMutex.Unlock()
+	fake.UpdateProjectMembershipStub = nil
+	fake.updateProjectMembershipReturns = struct {
+		result1 *pt.ProjectMembershipResponse
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) GetProjectReturnsOnCall(i int, result1 *pt.Project, result2 *http.Response, result3 error) {
-	fake.getProjectMutex.Lock()
-	defer fake.getProjectMutex.Unlock()
-	fake.GetProjectStub = nil
-	if fake.getProjectReturnsOnCall == nil {
-		fake.getProjectReturnsOnCall = make(map[int]struct {
-			result1 *pt.Project
+func (fake *FakeClientCaller) UpdateProjectMembershipReturnsOnCall(i int, result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.updateProjectMembershipMutex.Lock()
+	defer fake.updateProjectMembershipMutex.Unlock()
+	fake.UpdateProjectMembershipStub = nil
+	if fake.updateProjectMembershipReturnsOnCall == nil {
+		fake.updateProjectMembershipReturnsOnCall = make(map[int]struct {
+			result1 *pt.ProjectMembershipResponse
 			result2 *http.Response
 			result3 error
 		})
 	}
-	fake.getProjectReturnsOnCall[i] = struct {
-		result1 *pt.Project
+	fake.updateProjectMembershipReturnsOnCall[i] = struct {
+		result1 *pt.ProjectMembershipResponse
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) ListAccountMembers(arg1 int) ([]pt.AccountMember, *http.Response, error) {
-	fake.listAccountMembersMutex.Lock()
-	ret, specificReturn := fake.listAccountMembersReturnsOnCall[len(fake.listAccountMembersArgsForCall)]
-	fake.listAccountMembersArgsForCall = append(fake.listAccountMembersArgsForCall, struct {
+func (fake *FakeClientCaller) UpdateReview(arg1 int, arg2 int, arg3 int, arg4 pt.ReviewRequest) (*pt.Review, *http.Response, error) {
+	fake.updateReviewMutex.Lock()
+	ret, specificReturn := fake.updateReviewReturnsOnCall[len(fake.updateReviewArgsForCall)]
+	fake.updateReviewArgsForCall = append(fake.updateReviewArgsForCall, struct {
 		arg1 int
-	}{arg1})
-	fake.recordInvocation("ListAccountMembers", []interface{}{arg1})
-	fake.listAccountMembersMutex.Unlock()
-	if fake.ListAccountMembersStub != nil {
-		return fake.ListAccountMembersStub(arg1)
+		arg2 int
+		arg3 int
+		arg4 pt.ReviewRequest
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateReview", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateReviewMutex.Unlock()
+	if fake.UpdateReviewStub != nil {
+		return fake.UpdateReviewStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.listAccountMembersReturns
+	fakeReturns := fake.updateReviewReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) ListAccountMembersCallCount() int {
-	fake.listAccountMembersMutex.RLock()
-	defer fake.listAccountMembersMutex.RUnlock()
-	return len(fake.listAccountMembersArgsForCall)
+func (fake *FakeClientCaller) UpdateReviewCallCount() int {
+	fake.updateReviewMutex.RLock()
+	defer fake.updateReviewMutex.RUnlock()
+	return len(fake.updateReviewArgsForCall)
 }
 
-func (fake *FakeClientCaller) ListAccountMembersCalls(stub func(int) ([]pt.AccountMember, *http.Response, error)) {
-	fake.listAccountMembersMutex.Lock()
-	defer fake.listAccountMembersMutex.Unlock()
-	fake.ListAccountMembersStub = stub
+func (fake *FakeClientCaller) UpdateReviewCalls(stub func(int, int, int, pt.ReviewRequest) (*pt.Review, *http.Response, error)) {
+	fake.updateReviewMutex.Lock()
+	defer fake.updateReviewMutex.Unlock()
+	fake.UpdateReviewStub = stub
 }
 
-func (fake *FakeClientCaller) ListAccountMembersArgsForCall(i int) int {
-	fake.listAccountMembersMutex.RLock()
-	defer fake.listAccountMembersMutex.RUnlock()
-	argsForCall := fake.listAccountMembersArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeClientCaller) UpdateReviewArgsForCall(i int) (int, int, int, pt.ReviewRequest) {
+	fake.updateReviewMutex.RLock()
+	defer fake.updateReviewMutex.RUnlock()
+	argsForCall := fake.updateReviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeClientCaller) ListAccountMembersReturns(result1 []pt.AccountMember, result2 *http.Response, result3 error) {
-	fake.listAccountMembersMutex.Lock()
-	defer fake.listAccountMembersMutex.Unlock()
-	fake.ListAccountMembersStub = nil
-	fake.listAccountMembersReturns = struct {
-		result1 []pt.AccountMember
+func (fake *FakeClientCaller) UpdateReviewReturns(result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.updateReviewMutex.Lock()
+	defer fake.updateReviewMutex.Unlock()
+	fake.UpdateReviewStub = nil
+	fake.updateReviewReturns = struct {
+		result1 *pt.Review
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) ListAccountMembersReturnsOnCall(i int, result1 []pt.AccountMember, result2 *http.Response, result3 error) {
-	fake.listAccountMembersMutex.Lock()
-	defer fake.listAccountMembersMutex.Unlock()
-	fake.ListAccountMembersStub = nil
-	if fake.listAccountMembersReturnsOnCall == nil {
-		fake.listAccountMembersReturnsOnCall = make(map[int]struct {
-			result1 []pt.AccountMember
+func (fake *FakeClientCaller) UpdateReviewReturnsOnCall(i int, result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.updateReviewMutex.Lock()
+	defer fake.updateReviewMutex.Unlock()
+	fake.UpdateReviewStub = nil
+	if fake.updateReviewReturnsOnCall == nil {
+		fake.updateReviewReturnsOnCall = make(map[int]struct {
+			result1 *pt.Review
 			result2 *http.Response
 			result3 error
 		})
 	}
-	fake.listAccountMembersReturnsOnCall[i] = struct {
-		result1 []pt.AccountMember
+	fake.updateReviewReturnsOnCall[i] = struct {
+		result1 *pt.Review
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) ListProjects() ([]*pt.Project, *http.Response, error) {
-	fake.listProjectsMutex.Lock()
-	ret, specificReturn := fake.listProjectsReturnsOnCall[len(fake.listProjectsArgsForCall)]
-	fake.listProjectsArgsForCall = append(fake.listProjectsArgsForCall, struct {
-	}{})
-	fake.recordInvocation("ListProjects", []interface{}{})
-	fake.listProjectsMutex.Unlock()
-	if fake.ListProjectsStub != nil {
-		return fake.ListProjectsStub()
+func (fake *FakeClientCaller) UpdateReviewType(arg1 int, arg2 int, arg3 pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error) {
+	fake.updateReviewTypeMutex.Lock()
+	ret, specificReturn := fake.updateReviewTypeReturnsOnCall[len(fake.updateReviewTypeArgsForCall)]
+	fake.updateReviewTypeArgsForCall = append(fake.updateReviewTypeArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ReviewTypeRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateReviewType", []interface{}{arg1, arg2, arg3})
+	fake.updateReviewTypeMutex.Unlock()
+	if fake.UpdateReviewTypeStub != nil {
+		return fake.UpdateReviewTypeStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.listProjectsReturns
+	fakeReturns := fake.updateReviewTypeReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) ListProjectsCallCount() int {
-	fake.listProjectsMutex.RLock()
-	defer fake.listProjectsMutex.RUnlock()
-	return len(fake.listProjectsArgsForCall)
+func (fake *FakeClientCaller) UpdateReviewTypeCallCount() int {
+	fake.updateReviewTypeMutex.RLock()
+	defer fake.updateReviewTypeMutex.RUnlock()
+	return len(fake.updateReviewTypeArgsForCall)
 }
 
-func (fake *FakeClientCaller) ListProjectsCalls(stub func() ([]*pt.Project, *http.Response, error)) {
-	fake.listProjectsMutex.Lock()
-	defer fake.listProjectsMutex.Unlock()
-	fake.ListProjectsStub = stub
+func (fake *FakeClientCaller) UpdateReviewTypeCalls(stub func(int, int, pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error)) {
+	fake.updateReviewTypeMutex.Lock()
+	defer fake.updateReviewTypeMutex.Unlock()
+	fake.UpdateReviewTypeStub = stub
 }
 
-func (fake *FakeClientCaller) ListProjectsReturns(result1 []*pt.Project, result2 *http.Response, result3 error) {
-	fake.listProjectsMutex.Lock()
-	defer fake.listProjectsMutex.Unlock()
-	fake.ListProjectsStub = nil
-	fake.listProjectsReturns = struct {
-		result1 []*pt.Project
+func (fake *FakeClientCaller) UpdateReviewTypeArgsForCall(i int) (int, int, pt.ReviewTypeRequest) {
+	fake.updateReviewTypeMutex.RLock()
+	defer fake.updateReviewTypeMutex.RUnlock()
+	argsForCall := fake.updateReviewTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClientCaller) UpdateReviewTypeReturns(result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.updateReviewTypeMutex.Lock()
+	defer fake.updateReviewTypeMutex.Unlock()
+	fake.UpdateReviewTypeStub = nil
+	fake.updateReviewTypeReturns = struct {
+		result1 *pt.ReviewType
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) ListProjectsReturnsOnCall(i int, result1 []*pt.Project, result2 *http.Response, result3 error) {
-	fake.listProjectsMutex.Lock()
-	defer fake.listProjectsMutex.Unlock()
-	fake.ListProjectsStub = nil
-	if fake.listProjectsReturnsOnCall == nil {
-		fake.listProjectsReturnsOnCall = make(map[int]struct {
-			result1 []*pt.Project
+func (fake *FakeClientCaller) UpdateReviewTypeReturnsOnCall(i int, result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.updateReviewTypeMutex.Lock()
+	defer fake.updateReviewTypeMutex.Unlock()
+	fake.UpdateReviewTypeStub = nil
+	if fake.updateReviewTypeReturnsOnCall == nil {
+		fake.updateReviewTypeReturnsOnCall = make(map[int]struct {
+			result1 *pt.ReviewType
 			result2 *http.Response
 			result3 error
 		})
 	}
-	fake.listProjectsReturnsOnCall[i] = struct {
-		result1 []*pt.Project
+	fake.updateReviewTypeReturnsOnCall[i] = struct {
+		result1 *pt.ReviewType
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) NewAccountMember(arg1 int, arg2 pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error) {
-	fake.newAccountMemberMutex.Lock()
-	ret, specificReturn := fake.newAccountMemberReturnsOnCall[len(fake.newAccountMemberArgsForCall)]
-	fake.newAccountMemberArgsForCall = append(fake.newAccountMemberArgsForCall, struct {
+func (fake *FakeClientCaller) UpdateStory(arg1 int, arg2 int, arg3 pt.StoryRequest) (*pt.Story, *http.Response, error) {
+	fake.updateStoryMutex.Lock()
+	ret, specificReturn := fake.updateStoryReturnsOnCall[len(fake.updateStoryArgsForCall)]
+	fake.updateStoryArgsForCall = append(fake.updateStoryArgsForCall, struct {
 		arg1 int
-		arg2 pt.AccountMemberRequest
-	}{arg1, arg2})
-	fake.recordInvocation("NewAccountMember", []interface{}{arg1, arg2})
-	fake.newAccountMemberMutex.Unlock()
-	if fake.NewAccountMemberStub != nil {
-		return fake.NewAccountMemberStub(arg1, arg2)
+		arg2 int
+		arg3 pt.StoryRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateStory", []interface{}{arg1, arg2, arg3})
+	fake.updateStoryMutex.Unlock()
+	if fake.UpdateStoryStub != nil {
+		return fake.UpdateStoryStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.newAccountMemberReturns
+	fakeReturns := fake.updateStoryReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) NewAccountMemberCallCount() int {
-	fake.newAccountMemberMutex.RLock()
-	defer fake.newAccountMemberMutex.RUnlock()
-	return len(fake.newAccountMemberArgsForCall)
+func (fake *FakeClientCaller) UpdateStoryCallCount() int {
+	fake.updateStoryMutex.RLock()
+	defer fake.updateStoryMutex.RUnlock()
+	return len(fake.updateStoryArgsForCall)
 }
 
-func (fake *FakeClientCaller) NewAccountMemberCalls(stub func(int, pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error)) {
-	fake.newAccountMemberMutex.Lock()
-	defer fake.newAccountMemberMutex.Unlock()
-	fake.NewAccountMemberStub = stub
+func (fake *FakeClientCaller) UpdateStoryCalls(stub func(int, int, pt.StoryRequest) (*pt.Story, *http.Response, error)) {
+	fake.updateStoryMutex.Lock()
+	defer fake.updateStoryMutex.Unlock()
+	fake.UpdateStoryStub = stub
 }
 
-func (fake *FakeClientCaller) NewAccountMemberArgsForCall(i int) (int, pt.AccountMemberRequest) {
-	fake.newAccountMemberMutex.RLock()
-	defer fake.newAccountMemberMutex.RUnlock()
-	argsForCall := fake.newAccountMemberArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeClientCaller) UpdateStoryArgsForCall(i int) (int, int, pt.StoryRequest) {
+	fake.updateStoryMutex.RLock()
+	defer fake.updateStoryMutex.RUnlock()
+	argsForCall := fake.updateStoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeClientCaller) NewAccountMemberReturns(result1 *pt.AccountMember, result2 *http.Response, result3 error) {
-	fake.newAccountMemberMutex.Lock()
-	defer fake.newAccountMemberMutex.Unlock()
-	fake.NewAccountMemberStub = nil
-	fake.newAccountMemberReturns = struct {
-		result1 *pt.AccountMember
+func (fake *FakeClientCaller) UpdateStoryReturns(result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.updateStoryMutex.Lock()
+	defer fake.updateStoryMutex.Unlock()
+	fake.UpdateStoryStub = nil
+	fake.updateStoryReturns = struct {
+		result1 *pt.Story
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) NewAccountMemberReturnsOnCall(i int, result1 *pt.AccountMember, result2 *http.Response, result3 error) {
-	fake.newAccountMemberMutex.Lock()
-	defer fake.newAccountMemberMutex.Unlock()
-	fake.NewAccountMemberStub = nil
-	if fake.newAccountMemberReturnsOnCall == nil {
-		fake.newAccountMemberReturnsOnCall = make(map[int]struct {
-			result1 *pt.AccountMember
+func (fake *FakeClientCaller) UpdateStoryReturnsOnCall(i int, result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.updateStoryMutex.Lock()
+	defer fake.updateStoryMutex.Unlock()
+	fake.UpdateStoryStub = nil
+	if fake.updateStoryReturnsOnCall == nil {
+		fake.updateStoryReturnsOnCall = make(map[int]struct {
+			result1 *pt.Story
 			result2 *http.Response
 			result3 error
 		})
 	}
-	fake.newAccountMemberReturnsOnCall[i] = struct {
-		result1 *pt.AccountMember
+	fake.updateStoryReturnsOnCall[i] = struct {
+		result1 *pt.Story
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) NewProject(arg1 pt.ProjectsRequest) (*pt.Project, *http.Response, error) {
-	fake.newProjectMutex.Lock()
-	ret, specificReturn := fake.newProjectReturnsOnCall[len(fake.newProjectArgsForCall)]
-	fake.newProjectArgsForCall = append(fake.newProjectArgsForCall, struct {
-		arg1 pt.ProjectsRequest
-	}{arg1})
-	fake.recordInvocation("NewProject", []interface{}{arg1})
-	fake.newProjectMutex.Unlock()
-	if fake.NewProjectStub != nil {
-		return fake.NewProjectStub(arg1)
+func (fake *FakeClientCaller) UpdateTask(arg1 int, arg2 int, arg3 int, arg4 pt.TaskRequest) (*pt.Task, *http.Response, error) {
+	fake.updateTaskMutex.Lock()
+	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
+	fake.updateTaskArgsForCall = append(fake.updateTaskArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+		arg4 pt.TaskRequest
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateTaskMutex.Unlock()
+	if fake.UpdateTaskStub != nil {
+		return fake.UpdateTaskStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.newProjectReturns
+	fakeReturns := fake.updateTaskReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) NewProjectCallCount() int {
-	fake.newProjectMutex.RLock()
-	defer fake.newProjectMutex.RUnlock()
-	return len(fake.newProjectArgsForCall)
+func (fake *FakeClientCaller) UpdateTaskCallCount() int {
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	return len(fake.updateTaskArgsForCall)
 }
 
-func (fake *FakeClientCaller) NewProjectCalls(stub func(pt.ProjectsRequest) (*pt.Project, *http.Response, error)) {
-	fake.newProjectMutex.Lock()
-	defer fake.newProjectMutex.Unlock()
-	fake.NewProjectStub = stub
+func (fake *FakeClientCaller) UpdateTaskCalls(stub func(int, int, int, pt.TaskRequest) (*pt.Task, *http.Response, error)) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = stub
 }
 
-func (fake *FakeClientCaller) NewProjectArgsForCall(i int) pt.ProjectsRequest {
-	fake.newProjectMutex.RLock()
-	defer fake.newProjectMutex.RUnlock()
-	argsForCall := fake.newProjectArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeClientCaller) UpdateTaskArgsForCall(i int) (int, int, int, pt.TaskRequest) {
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	argsForCall := fake.updateTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeClientCaller) NewProjectReturns(result1 *pt.Project, result2 *http.Response, result3 error) {
-	fake.newProjectMutex.Lock()
-	defer fake.newProjectMutex.Unlock()
-	fake.NewProjectStub = nil
-	fake.newProjectReturns = struct {
-		result1 *pt.Project
+func (fake *FakeClientCaller) UpdateTaskReturns(result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = nil
+	fake.updateTaskReturns = struct {
+		result1 *pt.Task
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) NewProjectReturnsOnCall(i int, result1 *pt.Project, result2 *http.Response, result3 error) {
-	fake.newProjectMutex.Lock()
-	defer fake.newProjectMutex.Unlock()
-	fake.NewProjectStub = nil
-	if fake.newProjectReturnsOnCall == nil {
-		fake.newProjectReturnsOnCall = make(map[int]struct {
-			result1 *pt.Project
+func (fake *FakeClientCaller) UpdateTaskReturnsOnCall(i int, result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = nil
+	if fake.updateTaskReturnsOnCall == nil {
+		fake.updateTaskReturnsOnCall = make(map[int]struct {
+			result1 *pt.Task
 			result2 *http.Response
 			result3 error
 		})
 	}
-	fake.newProjectReturnsOnCall[i] = struct {
-		result1 *pt.Project
+	fake.updateTaskReturnsOnCall[i] = struct {
+		result1 *pt.Task
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) UpdateAccountMember(arg1 int, arg2 int, arg3 pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error) {
-	fake.updateAccountMemberMutex.Lock()
-	ret, specificReturn := fake.updateAccountMemberReturnsOnCall[len(fake.updateAccountMemberArgsForCall)]
-	fake.updateAccountMemberArgsForCall = append(fake.updateAccountMemberArgsForCall, struct {
+func (fake *FakeClientCaller) UpdateWebhook(arg1 int, arg2 int, arg3 pt.WebhookRequest) (*pt.Webhook, *http.Response, error) {
+	fake.updateWebhookMutex.Lock()
+	ret, specificReturn := fake.updateWebhookReturnsOnCall[len(fake.updateWebhookArgsForCall)]
+	fake.updateWebhookArgsForCall = append(fake.updateWebhookArgsForCall, struct {
 		arg1 int
 		arg2 int
-		arg3 pt.AccountMemberRequest
+		arg3 pt.WebhookRequest
 	}{arg1, arg2, arg3})
-	fake.recordInvocation("UpdateAccountMember", []interface{}{arg1, arg2, arg3})
-	fake.updateAccountMemberMutex.Unlock()
-	if fake.UpdateAccountMemberStub != nil {
-		return fake.UpdateAccountMemberStub(arg1, arg2, arg3)
+	fake.recordInvocation("UpdateWebhook", []interface{}{arg1, arg2, arg3})
+	fake.updateWebhookMutex.Unlock()
+	if fake.UpdateWebhookStub != nil {
+		return fake.UpdateWebhookStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.updateAccountMemberReturns
+	fakeReturns := fake.updateWebhookReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) UpdateAccountMemberCallCount() int {
-	fake.updateAccountMemberMutex.RLock()
-	defer fake.updateAccountMemberMutex.RUnlock()
-	return len(fake.updateAccountMemberArgsForCall)
+func (fake *FakeClientCaller) UpdateWebhookCallCount() int {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	return len(fake.updateWebhookArgsForCall)
 }
 
-func (fake *FakeClientCaller) UpdateAccountMemberCalls(stub func(int, int, pt.AccountMemberRequest) (*pt.AccountMember, *http.Response, error)) {
-	fake.updateAccountMemberMutex.Lock()
-	defer fake.updateAccountMemberMutex.Unlock()
-	fake.UpdateAccountMemberStub = stub
+func (fake *FakeClientCaller) UpdateWebhookCalls(stub func(int, int, pt.WebhookRequest) (*pt.Webhook, *http.Response, error)) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = stub
 }
 
-func (fake *FakeClientCaller) UpdateAccountMemberArgsForCall(i int) (int, int, pt.AccountMemberRequest) {
-	fake.updateAccountMemberMutex.RLock()
-	defer fake.updateAccountMemberMutex.RUnlock()
-	argsForCall := fake.updateAccountMemberArgsForCall[i]
+func (fake *FakeClientCaller) UpdateWebhookArgsForCall(i int) (int, int, pt.WebhookRequest) {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	argsForCall := fake.updateWebhookArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeClientCaller) UpdateAccountMemberReturns(result1 *pt.AccountMember, result2 *http.Response, result3 error) {
-	fake.updateAccountMemberMutex.Lock()
-	defer fake.updateAccountMemberMutex.Unlock()
-	fake.UpdateAccountMemberStub = nil
-	fake.updateAccountMemberReturns = struct {
-		result1 *pt.AccountMember
+func (fake *FakeClientCaller) UpdateWebhookReturns(result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	fake.updateWebhookReturns = struct {
+		result1 *pt.Webhook
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) UpdateAccountMemberReturnsOnCall(i int, result1 *pt.AccountMember, result2 *http.Response, result3 error) {
-	fake.updateAccountMemberMutex.Lock()
-	defer fake.updateAccountMemberMutex.Unlock()
-	fake.UpdateAccountMemberStub = nil
-	if fake.updateAccountMemberReturnsOnCall == nil {
-		fake.updateAccountMemberReturnsOnCall = make(map[int]struct {
-			result1 *pt.AccountMember
+func (fake *FakeClientCaller) UpdateWebhookReturnsOnCall(i int, result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	if fake.updateWebhookReturnsOnCall == nil {
+		fake.updateWebhookReturnsOnCall = make(map[int]struct {
+			result1 *pt.Webhook
 			result2 *http.Response
 			result3 error
 		})
 	}
-	fake.updateAccountMemberReturnsOnCall[i] = struct {
-		result1 *pt.AccountMember
+	fake.updateWebhookReturnsOnCall[i] = struct {
+		result1 *pt.Webhook
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) UpdateProject(arg1 int, arg2 pt.ProjectRequest) (*pt.Project, *http.Response, error) {
-	fake.updateProjectMutex.Lock()
-	ret, specificReturn := fake.updateProjectReturnsOnCall[len(fake.updateProjectArgsForCall)]
-	fake.updateProjectArgsForCall = append(fake.updateProjectArgsForCall, struct {
+func (fake *FakeClientCaller) UpdateWorkspace(arg1 int, arg2 pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error) {
+	fake.updateWorkspaceMutex.Lock()
+	ret, specificReturn := fake.updateWorkspaceReturnsOnCall[len(fake.updateWorkspaceArgsForCall)]
+	fake.updateWorkspaceArgsForCall = append(fake.updateWorkspaceArgsForCall, struct {
 		arg1 int
-		arg2 pt.ProjectRequest
+		arg2 pt.WorkspaceRequest
 	}{arg1, arg2})
-	fake.recordInvocation("UpdateProject", []interface{}{arg1, arg2})
-	fake.updateProjectMutex.Unlock()
-	if fake.UpdateProjectStub != nil {
-		return fake.UpdateProjectStub(arg1, arg2)
+	fake.recordInvocation("UpdateWorkspace", []interface{}{arg1, arg2})
+	fake.updateWorkspaceMutex.Unlock()
+	if fake.UpdateWorkspaceStub != nil {
+		return fake.UpdateWorkspaceStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.updateProjectReturns
+	fakeReturns := fake.updateWorkspaceReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeClientCaller) UpdateProjectCallCount() int {
-	fake.updateProjectMutex.RLock()
-	defer fake.updateProjectMutex.RUnlock()
-	return len(fake.updateProjectArgsForCall)
+func (fake *FakeClientCaller) UpdateWorkspaceCallCount() int {
+	fake.updateWorkspaceMutex.RLock()
+	defer fake.updateWorkspaceMutex.RUnlock()
+	return len(fake.updateWorkspaceArgsForCall)
 }
 
-func (fake *FakeClientCaller) UpdateProjectCalls(stub func(int, pt.ProjectRequest) (*pt.Project, *http.Response, error)) {
-	fake.updateProjectMutex.Lock()
-	defer fake.updateProjectMutex.Unlock()
-	fake.UpdateProjectStub = stub
+func (fake *FakeClientCaller) UpdateWorkspaceCalls(stub func(int, pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error)) {
+	fake.updateWorkspaceMutex.Lock()
+	defer fake.updateWorkspaceMutex.Unlock()
+	fake.UpdateWorkspaceStub = stub
 }
 
-func (fake *FakeClientCaller) UpdateProjectArgsForCall(i int) (int, pt.ProjectRequest) {
-	fake.updateProjectMutex.RLock()
-	defer fake.updateProjectMutex.RUnlock()
-	argsForCall := fake.updateProjectArgsForCall[i]
+func (fake *FakeClientCaller) UpdateWorkspaceArgsForCall(i int) (int, pt.WorkspaceRequest) {
+	fake.updateWorkspaceMutex.RLock()
+	defer fake.updateWorkspaceMutex.RUnlock()
+	argsForCall := fake.updateWorkspaceArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeClientCaller) UpdateProjectReturns(result1 *pt.Project, result2 *http.Response, result3 error) {
-	fake.updateProjectMutex.Lock()
-	defer fake.updateProjectMutex.Unlock()
-	fake.UpdateProjectStub = nil
-	fake.updateProjectReturns = struct {
-		result1 *pt.Project
+func (fake *FakeClientCaller) UpdateWorkspaceReturns(result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.updateWorkspaceMutex.Lock()
+	defer fake.updateWorkspaceMutex.Unlock()
+	fake.UpdateWorkspaceStub = nil
+	fake.updateWorkspaceReturns = struct {
+		result1 *pt.Workspace
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClientCaller) UpdateProjectReturnsOnCall(i int, result1 *pt.Project, result2 *http.Response, result3 error) {
-	fake.updateProjectMutex.Lock()
-	defer fake.updateProjectMutex.Unlock()
-	fake.UpdateProjectStub = nil
-	if fake.updateProjectReturnsOnCall == nil {
-		fake.updateProjectReturnsOnCall = make(map[int]struct {
-			result1 *pt.Project
+func (fake *FakeClientCaller) UpdateWorkspaceReturnsOnCall(i int, result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.updateWorkspaceMutex.Lock()
+	defer fake.updateWorkspaceMutex.Unlock()
+	fake.UpdateWorkspaceStub = nil
+	if fake.updateWorkspaceReturnsOnCall == nil {
+		fake.updateWorkspaceReturnsOnCall = make(map[int]struct {
+			result1 *pt.Workspace
 			result2 *http.Response
 			result3 error
 		})
 	}
-	fake.updateProjectReturnsOnCall[i] = struct {
-		result1 *pt.Project
+	fake.updateWorkspaceReturnsOnCall[i] = struct {
+		result1 *pt.Workspace
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
@@ -821,24 +5481,138 @@ func (fake *FakeClientCaller) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.deleteAccountMemberMutex.RLock()
 	defer fake.deleteAccountMemberMutex.RUnlock()
+	fake.deleteEpicMutex.RLock()
+	defer fake.deleteEpicMutex.RUnlock()
+	fake.deleteIntegrationMutex.RLock()
+	defer fake.deleteIntegrationMutex.RUnlock()
+	fake.deleteLabelMutex.RLock()
+	defer fake.deleteLabelMutex.RUnlock()
 	fake.deleteProjectMutex.RLock()
 	defer fake.deleteProjectMutex.RUnlock()
+	fake.deleteProjectMembershipMutex.RLock()
+	defer fake.deleteProjectMembershipMutex.RUnlock()
+	fake.deleteReviewMutex.RLock()
+	defer fake.deleteReviewMutex.RUnlock()
+	fake.deleteReviewTypeMutex.RLock()
+	defer fake.deleteReviewTypeMutex.RUnlock()
+	fake.deleteStoryMutex.RLock()
+	defer fake.deleteStoryMutex.RUnlock()
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	fake.deleteWorkspaceMutex.RLock()
+	defer fake.deleteWorkspaceMutex.RUnlock()
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
 	fake.getAccountMemberMutex.RLock()
 	defer fake.getAccountMemberMutex.RUnlock()
+	fake.getEpicMutex.RLock()
+	defer fake.getEpicMutex.RUnlock()
+	fake.getIntegrationMutex.RLock()
+	defer fake.getIntegrationMutex.RUnlock()
+	fake.getIterationMutex.RLock()
+	defer fake.getIterationMutex.RUnlock()
+	fake.getLabelMutex.RLock()
+	defer fake.getLabelMutex.RUnlock()
+	fake.getMeMutex.RLock()
+	defer fake.getMeMutex.RUnlock()
 	fake.getProjectMutex.RLock()
 	defer fake.getProjectMutex.RUnlock()
+	fake.getProjectMembershipMutex.RLock()
+	defer fake.getProjectMembershipMutex.RUnlock()
+	fake.getReviewMutex.RLock()
+	defer fake.getReviewMutex.RUnlock()
+	fake.getReviewTypeMutex.RLock()
+	defer fake.getReviewTypeMutex.RUnlock()
+	fake.getStoryMutex.RLock()
+	defer fake.getStoryMutex.RUnlock()
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	fake.getWorkspaceMutex.RLock()
+	defer fake.getWorkspaceMutex.RUnlock()
 	fake.listAccountMembersMutex.RLock()
 	defer fake.listAccountMembersMutex.RUnlock()
+	fake.listAccountsMutex.RLock()
+	defer fake.listAccountsMutex.RUnlock()
+	fake.listEpicsMutex.RLock()
+	defer fake.listEpicsMutex.RUnlock()
+	fake.listIntegrationsMutex.RLock()
+	defer fake.listIntegrationsMutex.RUnlock()
+	fake.listIterationOverridesMutex.RLock()
+	defer fake.listIterationOverridesMutex.RUnlock()
+	fake.listLabelsMutex.RLock()
+	defer fake.listLabelsMutex.RUnlock()
+	fake.listProjectMembershipsMutex.RLock()
+	defer fake.listProjectMembershipsMutex.RUnlock()
 	fake.listProjectsMutex.RLock()
 	defer fake.listProjectsMutex.RUnlock()
+	fake.listReviewTypesMutex.RLock()
+	defer fake.listReviewTypesMutex.RUnlock()
+	fake.listReviewsMutex.RLock()
+	defer fake.listReviewsMutex.RUnlock()
+	fake.listStoriesMutex.RLock()
+	defer fake.listStoriesMutex.RUnlock()
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	fake.listWorkspacesMutex.RLock()
+	defer fake.listWorkspacesMutex.RUnlock()
 	fake.newAccountMemberMutex.RLock()
 	defer fake.newAccountMemberMutex.RUnlock()
+	fake.newEpicMutex.RLock()
+	defer fake.newEpicMutex.RUnlock()
+	fake.newIntegrationMutex.RLock()
+	defer fake.newIntegrationMutex.RUnlock()
+	fake.newLabelMutex.RLock()
+	defer fake.newLabelMutex.RUnlock()
 	fake.newProjectMutex.RLock()
 	defer fake.newProjectMutex.RUnlock()
+	fake.newProjectMembershipMutex.RLock()
+	defer fake.newProjectMembershipMutex.RUnlock()
+	fake.newReviewMutex.RLock()
+	defer fake.newReviewMutex.RUnlock()
+	fake.newReviewTypeMutex.RLock()
+	defer fake.newReviewTypeMutex.RUnlock()
+	fake.newStoryMutex.RLock()
+	defer fake.newStoryMutex.RUnlock()
+	fake.newTaskMutex.RLock()
+	defer fake.newTaskMutex.RUnlock()
+	fake.newWebhookMutex.RLock()
+	defer fake.newWebhookMutex.RUnlock()
+	fake.newWorkspaceMutex.RLock()
+	defer fake.newWorkspaceMutex.RUnlock()
+	fake.updateAccountMutex.RLock()
+	defer fake.updateAccountMutex.RUnlock()
 	fake.updateAccountMemberMutex.RLock()
 	defer fake.updateAccountMemberMutex.RUnlock()
+	fake.updateEpicMutex.RLock()
+	defer fake.updateEpicMutex.RUnlock()
+	fake.updateIntegrationMutex.RLock()
+	defer fake.updateIntegrationMutex.RUnlock()
+	fake.updateIterationOverrideMutex.RLock()
+	defer fake.updateIterationOverrideMutex.RUnlock()
+	fake.updateLabelMutex.RLock()
+	defer fake.updateLabelMutex.RUnlock()
 	fake.updateProjectMutex.RLock()
 	defer fake.updateProjectMutex.RUnlock()
+	fake.updateProjectMembershipMutex.RLock()
+	defer fake.updateProjectMembershipMutex.RUnlock()
+	fake.updateReviewMutex.RLock()
+	defer fake.updateReviewMutex.RUnlock()
+	fake.updateReviewTypeMutex.RLock()
+	defer fake.updateReviewTypeMutex.RUnlock()
+	fake.updateStoryMutex.RLock()
+	defer fake.updateStoryMutex.RUnlock()
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	fake.updateWorkspaceMutex.RLock()
+	defer fake.updateWorkspaceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
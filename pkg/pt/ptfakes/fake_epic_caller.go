@@ -0,0 +1,458 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeEpicCaller struct {
+	DeleteEpicStub        func(int, int) (*http.Response, error)
+	deleteEpicMutex       sync.RWMutex
+	deleteEpicArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteEpicReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteEpicReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetEpicStub        func(int, int) (*pt.Epic, *http.Response, error)
+	getEpicMutex       sync.RWMutex
+	getEpicArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getEpicReturns struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	getEpicReturnsOnCall map[int]struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	ListEpicsStub        func(int) ([]pt.Epic, *http.Response, error)
+	listEpicsMutex       sync.RWMutex
+	listEpicsArgsForCall []struct {
+		arg1 int
+	}
+	listEpicsReturns struct {
+		result1 []pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	listEpicsReturnsOnCall map[int]struct {
+		result1 []pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	NewEpicStub        func(int, pt.EpicRequest) (*pt.Epic, *http.Response, error)
+	newEpicMutex       sync.RWMutex
+	newEpicArgsForCall []struct {
+		arg1 int
+		arg2 pt.EpicRequest
+	}
+	newEpicReturns struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	newEpicReturnsOnCall map[int]struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	UpdateEpicStub        func(int, int, pt.EpicRequest) (*pt.Epic, *http.Response, error)
+	updateEpicMutex       sync.RWMutex
+	updateEpicArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.EpicRequest
+	}
+	updateEpicReturns struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	updateEpicReturnsOnCall map[int]struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEpicCaller) DeleteEpic(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteEpicMutex.Lock()
+	ret, specificReturn := fake.deleteEpicReturnsOnCall[len(fake.deleteEpicArgsForCall)]
+	fake.deleteEpicArgsForCall = append(fake.deleteEpicArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteEpic", []interface{}{arg1, arg2})
+	fake.deleteEpicMutex.Unlock()
+	if fake.DeleteEpicStub != nil {
+		return fake.DeleteEpicStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteEpicReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEpicCaller) DeleteEpicCallCount() int {
+	fake.deleteEpicMutex.RLock()
+	defer fake.deleteEpicMutex.RUnlock()
+	return len(fake.deleteEpicArgsForCall)
+}
+
+func (fake *FakeEpicCaller) DeleteEpicCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteEpicMutex.Lock()
+	defer fake.deleteEpicMutex.Unlock()
+	fake.DeleteEpicStub = stub
+}
+
+func (fake *FakeEpicCaller) DeleteEpicArgsForCall(i int) (int, int) {
+	fake.deleteEpicMutex.RLock()
+	defer fake.deleteEpicMutex.RUnlock()
+	argsForCall := fake.deleteEpicArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEpicCaller) DeleteEpicReturns(result1 *http.Response, result2 error) {
+	fake.deleteEpicMutex.Lock()
+	defer fake.deleteEpicMutex.Unlock()
+	fake.DeleteEpicStub = nil
+	fake.deleteEpicReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEpicCaller) DeleteEpicReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteEpicMutex.Lock()
+	defer fake.deleteEpicMutex.Unlock()
+	fake.DeleteEpicStub = nil
+	if fake.deleteEpicReturnsOnCall == nil {
+		fake.deleteEpicReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteEpicReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEpicCaller) GetEpic(arg1 int, arg2 int) (*pt.Epic, *http.Response, error) {
+	fake.getEpicMutex.Lock()
+	ret, specificReturn := fake.getEpicReturnsOnCall[len(fake.getEpicArgsForCall)]
+	fake.getEpicArgsForCall = append(fake.getEpicArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetEpic", []interface{}{arg1, arg2})
+	fake.getEpicMutex.Unlock()
+	if fake.GetEpicStub != nil {
+		return fake.GetEpicStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEpicReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeEpicCaller) GetEpicCallCount() int {
+	fake.getEpicMutex.RLock()
+	defer fake.getEpicMutex.RUnlock()
+	return len(fake.getEpicArgsForCall)
+}
+
+func (fake *FakeEpicCaller) GetEpicCalls(stub func(int, int) (*pt.Epic, *http.Response, error)) {
+	fake.getEpicMutex.Lock()
+	defer fake.getEpicMutex.Unlock()
+	fake.GetEpicStub = stub
+}
+
+func (fake *FakeEpicCaller) GetEpicArgsForCall(i int) (int, int) {
+	fake.getEpicMutex.RLock()
+	defer fake.getEpicMutex.RUnlock()
+	argsForCall := fake.getEpicArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEpicCaller) GetEpicReturns(result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.getEpicMutex.Lock()
+	defer fake.getEpicMutex.Unlock()
+	fake.GetEpicStub = nil
+	fake.getEpicReturns = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEpicCaller) GetEpicReturnsOnCall(i int, result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.getEpicMutex.Lock()
+	defer fake.getEpicMutex.Unlock()
+	fake.GetEpicStub = nil
+	if fake.getEpicReturnsOnCall == nil {
+		fake.getEpicReturnsOnCall = make(map[int]struct {
+			result1 *pt.Epic
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getEpicReturnsOnCall[i] = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEpicCaller) ListEpics(arg1 int) ([]pt.Epic, *http.Response, error) {
+	fake.listEpicsMutex.Lock()
+	ret, specificReturn := fake.listEpicsReturnsOnCall[len(fake.listEpicsArgsForCall)]
+	fake.listEpicsArgsForCall = append(fake.listEpicsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListEpics", []interface{}{arg1})
+	fake.listEpicsMutex.Unlock()
+	if fake.ListEpicsStub != nil {
+		return fake.ListEpicsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listEpicsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeEpicCaller) ListEpicsCallCount() int {
+	fake.listEpicsMutex.RLock()
+	defer fake.listEpicsMutex.RUnlock()
+	return len(fake.listEpicsArgsForCall)
+}
+
+func (fake *FakeEpicCaller) ListEpicsCalls(stub func(int) ([]pt.Epic, *http.Response, error)) {
+	fake.listEpicsMutex.Lock()
+	defer fake.listEpicsMutex.Unlock()
+	fake.ListEpicsStub = stub
+}
+
+func (fake *FakeEpicCaller) ListEpicsArgsForCall(i int) int {
+	fake.listEpicsMutex.RLock()
+	defer fake.listEpicsMutex.RUnlock()
+	argsForCall := fake.listEpicsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEpicCaller) ListEpicsReturns(result1 []pt.Epic, result2 *http.Response, result3 error) {
+	fake.listEpicsMutex.Lock()
+	defer fake.listEpicsMutex.Unlock()
+	fake.ListEpicsStub = nil
+	fake.listEpicsReturns = struct {
+		result1 []pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEpicCaller) ListEpicsReturnsOnCall(i int, result1 []pt.Epic, result2 *http.Response, result3 error) {
+	fake.listEpicsMutex.Lock()
+	defer fake.listEpicsMutex.Unlock()
+	fake.ListEpicsStub = nil
+	if fake.listEpicsReturnsOnCall == nil {
+		fake.listEpicsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Epic
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listEpicsReturnsOnCall[i] = struct {
+		result1 []pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEpicCaller) NewEpic(arg1 int, arg2 pt.EpicRequest) (*pt.Epic, *http.Response, error) {
+	fake.newEpicMutex.Lock()
+	ret, specificReturn := fake.newEpicReturnsOnCall[len(fake.newEpicArgsForCall)]
+	fake.newEpicArgsForCall = append(fake.newEpicArgsForCall, struct {
+		arg1 int
+		arg2 pt.EpicRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewEpic", []interface{}{arg1, arg2})
+	fake.newEpicMutex.Unlock()
+	if fake.NewEpicStub != nil {
+		return fake.NewEpicStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newEpicReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeEpicCaller) NewEpicCallCount() int {
+	fake.newEpicMutex.RLock()
+	defer fake.newEpicMutex.RUnlock()
+	return len(fake.newEpicArgsForCall)
+}
+
+func (fake *FakeEpicCaller) NewEpicCalls(stub func(int, pt.EpicRequest) (*pt.Epic, *http.Response, error)) {
+	fake.newEpicMutex.Lock()
+	defer fake.newEpicMutex.Unlock()
+	fake.NewEpicStub = stub
+}
+
+func (fake *FakeEpicCaller) NewEpicArgsForCall(i int) (int, pt.EpicRequest) {
+	fake.newEpicMutex.RLock()
+	defer fake.newEpicMutex.RUnlock()
+	argsForCall := fake.newEpicArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEpicCaller) NewEpicReturns(result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.newEpicMutex.Lock()
+	defer fake.newEpicMutex.Unlock()
+	fake.NewEpicStub = nil
+	fake.newEpicReturns = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEpicCaller) NewEpicReturnsOnCall(i int, result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.newEpicMutex.Lock()
+	defer fake.newEpicMutex.Unlock()
+	fake.NewEpicStub = nil
+	if fake.newEpicReturnsOnCall == nil {
+		fake.newEpicReturnsOnCall = make(map[int]struct {
+			result1 *pt.Epic
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newEpicReturnsOnCall[i] = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEpicCaller) UpdateEpic(arg1 int, arg2 int, arg3 pt.EpicRequest) (*pt.Epic, *http.Response, error) {
+	fake.updateEpicMutex.Lock()
+	ret, specificReturn := fake.updateEpicReturnsOnCall[len(fake.updateEpicArgsForCall)]
+	fake.updateEpicArgsForCall = append(fake.updateEpicArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.EpicRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateEpic", []interface{}{arg1, arg2, arg3})
+	fake.updateEpicMutex.Unlock()
+	if fake.UpdateEpicStub != nil {
+		return fake.UpdateEpicStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateEpicReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeEpicCaller) UpdateEpicCallCount() int {
+	fake.updateEpicMutex.RLock()
+	defer fake.updateEpicMutex.RUnlock()
+	return len(fake.updateEpicArgsForCall)
+}
+
+func (fake *FakeEpicCaller) UpdateEpicCalls(stub func(int, int, pt.EpicRequest) (*pt.Epic, *http.Response, error)) {
+	fake.updateEpicMutex.Lock()
+	defer fake.updateEpicMutex.Unlock()
+	fake.UpdateEpicStub = stub
+}
+
+func (fake *FakeEpicCaller) UpdateEpicArgsForCall(i int) (int, int, pt.EpicRequest) {
+	fake.updateEpicMutex.RLock()
+	defer fake.updateEpicMutex.RUnlock()
+	argsForCall := fake.updateEpicArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEpicCaller) UpdateEpicReturns(result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.updateEpicMutex.Lock()
+	defer fake.updateEpicMutex.Unlock()
+	fake.UpdateEpicStub = nil
+	fake.updateEpicReturns = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEpicCaller) UpdateEpicReturnsOnCall(i int, result1 *pt.Epic, result2 *http.Response, result3 error) {
+	fake.updateEpicMutex.Lock()
+	defer fake.updateEpicMutex.Unlock()
+	fake.UpdateEpicStub = nil
+	if fake.updateEpicReturnsOnCall == nil {
+		fake.updateEpicReturnsOnCall = make(map[int]struct {
+			result1 *pt.Epic
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateEpicReturnsOnCall[i] = struct {
+		result1 *pt.Epic
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEpicCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteEpicMutex.RLock()
+	defer fake.deleteEpicMutex.RUnlock()
+	fake.getEpicMutex.RLock()
+	defer fake.getEpicMutex.RUnlock()
+	fake.listEpicsMutex.RLock()
+	defer fake.listEpicsMutex.RUnlock()
+	fake.newEpicMutex.RLock()
+	defer fake.newEpicMutex.RUnlock()
+	fake.updateEpicMutex.RLock()
+	defer fake.updateEpicMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEpicCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.EpicCaller = new(FakeEpicCaller)
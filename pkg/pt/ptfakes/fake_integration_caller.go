@@ -0,0 +1,458 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeIntegrationCaller struct {
+	DeleteIntegrationStub        func(int, int) (*http.Response, error)
+	deleteIntegrationMutex       sync.RWMutex
+	deleteIntegrationArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteIntegrationReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteIntegrationReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetIntegrationStub        func(int, int) (*pt.Integration, *http.Response, error)
+	getIntegrationMutex       sync.RWMutex
+	getIntegrationArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getIntegrationReturns struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	getIntegrationReturnsOnCall map[int]struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	ListIntegrationsStub        func(int) ([]pt.Integration, *http.Response, error)
+	listIntegrationsMutex       sync.RWMutex
+	listIntegrationsArgsForCall []struct {
+		arg1 int
+	}
+	listIntegrationsReturns struct {
+		result1 []pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	listIntegrationsReturnsOnCall map[int]struct {
+		result1 []pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	NewIntegrationStub        func(int, pt.IntegrationRequest) (*pt.Integration, *http.Response, error)
+	newIntegrationMutex       sync.RWMutex
+	newIntegrationArgsForCall []struct {
+		arg1 int
+		arg2 pt.IntegrationRequest
+	}
+	newIntegrationReturns struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	newIntegrationReturnsOnCall map[int]struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	UpdateIntegrationStub        func(int, int, pt.IntegrationRequest) (*pt.Integration, *http.Response, error)
+	updateIntegrationMutex       sync.RWMutex
+	updateIntegrationArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.IntegrationRequest
+	}
+	updateIntegrationReturns struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	updateIntegrationReturnsOnCall map[int]struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIntegrationCaller) DeleteIntegration(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteIntegrationMutex.Lock()
+	ret, specificReturn := fake.deleteIntegrationReturnsOnCall[len(fake.deleteIntegrationArgsForCall)]
+	fake.deleteIntegrationArgsForCall = append(fake.deleteIntegrationArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteIntegration", []interface{}{arg1, arg2})
+	fake.deleteIntegrationMutex.Unlock()
+	if fake.DeleteIntegrationStub != nil {
+		return fake.DeleteIntegrationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteIntegrationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIntegrationCaller) DeleteIntegrationCallCount() int {
+	fake.deleteIntegrationMutex.RLock()
+	defer fake.deleteIntegrationMutex.RUnlock()
+	return len(fake.deleteIntegrationArgsForCall)
+}
+
+func (fake *FakeIntegrationCaller) DeleteIntegrationCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteIntegrationMutex.Lock()
+	defer fake.deleteIntegrationMutex.Unlock()
+	fake.DeleteIntegrationStub = stub
+}
+
+func (fake *FakeIntegrationCaller) DeleteIntegrationArgsForCall(i int) (int, int) {
+	fake.deleteIntegrationMutex.RLock()
+	defer fake.deleteIntegrationMutex.RUnlock()
+	argsForCall := fake.deleteIntegrationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIntegrationCaller) DeleteIntegrationReturns(result1 *http.Response, result2 error) {
+	fake.deleteIntegrationMutex.Lock()
+	defer fake.deleteIntegrationMutex.Unlock()
+	fake.DeleteIntegrationStub = nil
+	fake.deleteIntegrationReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIntegrationCaller) DeleteIntegrationReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteIntegrationMutex.Lock()
+	defer fake.deleteIntegrationMutex.Unlock()
+	fake.DeleteIntegrationStub = nil
+	if fake.deleteIntegrationReturnsOnCall == nil {
+		fake.deleteIntegrationReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteIntegrationReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIntegrationCaller) GetIntegration(arg1 int, arg2 int) (*pt.Integration, *http.Response, error) {
+	fake.getIntegrationMutex.Lock()
+	ret, specificReturn := fake.getIntegrationReturnsOnCall[len(fake.getIntegrationArgsForCall)]
+	fake.getIntegrationArgsForCall = append(fake.getIntegrationArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetIntegration", []interface{}{arg1, arg2})
+	fake.getIntegrationMutex.Unlock()
+	if fake.GetIntegrationStub != nil {
+		return fake.GetIntegrationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getIntegrationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeIntegrationCaller) GetIntegrationCallCount() int {
+	fake.getIntegrationMutex.RLock()
+	defer fake.getIntegrationMutex.RUnlock()
+	return len(fake.getIntegrationArgsForCall)
+}
+
+func (fake *FakeIntegrationCaller) GetIntegrationCalls(stub func(int, int) (*pt.Integration, *http.Response, error)) {
+	fake.getIntegrationMutex.Lock()
+	defer fake.getIntegrationMutex.Unlock()
+	fake.GetIntegrationStub = stub
+}
+
+func (fake *FakeIntegrationCaller) GetIntegrationArgsForCall(i int) (int, int) {
+	fake.getIntegrationMutex.RLock()
+	defer fake.getIntegrationMutex.RUnlock()
+	argsForCall := fake.getIntegrationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIntegrationCaller) GetIntegrationReturns(result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.getIntegrationMutex.Lock()
+	defer fake.getIntegrationMutex.Unlock()
+	fake.GetIntegrationStub = nil
+	fake.getIntegrationReturns = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIntegrationCaller) GetIntegrationReturnsOnCall(i int, result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.getIntegrationMutex.Lock()
+	defer fake.getIntegrationMutex.Unlock()
+	fake.GetIntegrationStub = nil
+	if fake.getIntegrationReturnsOnCall == nil {
+		fake.getIntegrationReturnsOnCall = make(map[int]struct {
+			result1 *pt.Integration
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getIntegrationReturnsOnCall[i] = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIntegrationCaller) ListIntegrations(arg1 int) ([]pt.Integration, *http.Response, error) {
+	fake.listIntegrationsMutex.Lock()
+	ret, specificReturn := fake.listIntegrationsReturnsOnCall[len(fake.listIntegrationsArgsForCall)]
+	fake.listIntegrationsArgsForCall = append(fake.listIntegrationsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListIntegrations", []interface{}{arg1})
+	fake.listIntegrationsMutex.Unlock()
+	if fake.ListIntegrationsStub != nil {
+		return fake.ListIntegrationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listIntegrationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeIntegrationCaller) ListIntegrationsCallCount() int {
+	fake.listIntegrationsMutex.RLock()
+	defer fake.listIntegrationsMutex.RUnlock()
+	return len(fake.listIntegrationsArgsForCall)
+}
+
+func (fake *FakeIntegrationCaller) ListIntegrationsCalls(stub func(int) ([]pt.Integration, *http.Response, error)) {
+	fake.listIntegrationsMutex.Lock()
+	defer fake.listIntegrationsMutex.Unlock()
+	fake.ListIntegrationsStub = stub
+}
+
+func (fake *FakeIntegrationCaller) ListIntegrationsArgsForCall(i int) int {
+	fake.listIntegrationsMutex.RLock()
+	defer fake.listIntegrationsMutex.RUnlock()
+	argsForCall := fake.listIntegrationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeIntegrationCaller) ListIntegrationsReturns(result1 []pt.Integration, result2 *http.Response, result3 error) {
+	fake.listIntegrationsMutex.Lock()
+	defer fake.listIntegrationsMutex.Unlock()
+	fake.ListIntegrationsStub = nil
+	fake.listIntegrationsReturns = struct {
+		result1 []pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIntegrationCaller) ListIntegrationsReturnsOnCall(i int, result1 []pt.Integration, result2 *http.Response, result3 error) {
+	fake.listIntegrationsMutex.Lock()
+	defer fake.listIntegrationsMutex.Unlock()
+	fake.ListIntegrationsStub = nil
+	if fake.listIntegrationsReturnsOnCall == nil {
+		fake.listIntegrationsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Integration
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listIntegrationsReturnsOnCall[i] = struct {
+		result1 []pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIntegrationCaller) NewIntegration(arg1 int, arg2 pt.IntegrationRequest) (*pt.Integration, *http.Response, error) {
+	fake.newIntegrationMutex.Lock()
+	ret, specificReturn := fake.newIntegrationReturnsOnCall[len(fake.newIntegrationArgsForCall)]
+	fake.newIntegrationArgsForCall = append(fake.newIntegrationArgsForCall, struct {
+		arg1 int
+		arg2 pt.IntegrationRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewIntegration", []interface{}{arg1, arg2})
+	fake.newIntegrationMutex.Unlock()
+	if fake.NewIntegrationStub != nil {
+		return fake.NewIntegrationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newIntegrationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeIntegrationCaller) NewIntegrationCallCount() int {
+	fake.newIntegrationMutex.RLock()
+	defer fake.newIntegrationMutex.RUnlock()
+	return len(fake.newIntegrationArgsForCall)
+}
+
+func (fake *FakeIntegrationCaller) NewIntegrationCalls(stub func(int, pt.IntegrationRequest) (*pt.Integration, *http.Response, error)) {
+	fake.newIntegrationMutex.Lock()
+	defer fake.newIntegrationMutex.Unlock()
+	fake.NewIntegrationStub = stub
+}
+
+func (fake *FakeIntegrationCaller) NewIntegrationArgsForCall(i int) (int, pt.IntegrationRequest) {
+	fake.newIntegrationMutex.RLock()
+	defer fake.newIntegrationMutex.RUnlock()
+	argsForCall := fake.newIntegrationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIntegrationCaller) NewIntegrationReturns(result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.newIntegrationMutex.Lock()
+	defer fake.newIntegrationMutex.Unlock()
+	fake.NewIntegrationStub = nil
+	fake.newIntegrationReturns = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIntegrationCaller) NewIntegrationReturnsOnCall(i int, result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.newIntegrationMutex.Lock()
+	defer fake.newIntegrationMutex.Unlock()
+	fake.NewIntegrationStub = nil
+	if fake.newIntegrationReturnsOnCall == nil {
+		fake.newIntegrationReturnsOnCall = make(map[int]struct {
+			result1 *pt.Integration
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newIntegrationReturnsOnCall[i] = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIntegrationCaller) UpdateIntegration(arg1 int, arg2 int, arg3 pt.IntegrationRequest) (*pt.Integration, *http.Response, error) {
+	fake.updateIntegrationMutex.Lock()
+	ret, specificReturn := fake.updateIntegrationReturnsOnCall[len(fake.updateIntegrationArgsForCall)]
+	fake.updateIntegrationArgsForCall = append(fake.updateIntegrationArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.IntegrationRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateIntegration", []interface{}{arg1, arg2, arg3})
+	fake.updateIntegrationMutex.Unlock()
+	if fake.UpdateIntegrationStub != nil {
+		return fake.UpdateIntegrationStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateIntegrationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeIntegrationCaller) UpdateIntegrationCallCount() int {
+	fake.updateIntegrationMutex.RLock()
+	defer fake.updateIntegrationMutex.RUnlock()
+	return len(fake.updateIntegrationArgsForCall)
+}
+
+func (fake *FakeIntegrationCaller) UpdateIntegrationCalls(stub func(int, int, pt.IntegrationRequest) (*pt.Integration, *http.Response, error)) {
+	fake.updateIntegrationMutex.Lock()
+	defer fake.updateIntegrationMutex.Unlock()
+	fake.UpdateIntegrationStub = stub
+}
+
+func (fake *FakeIntegrationCaller) UpdateIntegrationArgsForCall(i int) (int, int, pt.IntegrationRequest) {
+	fake.updateIntegrationMutex.RLock()
+	defer fake.updateIntegrationMutex.RUnlock()
+	argsForCall := fake.updateIntegrationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIntegrationCaller) UpdateIntegrationReturns(result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.updateIntegrationMutex.Lock()
+	defer fake.updateIntegrationMutex.Unlock()
+	fake.UpdateIntegrationStub = nil
+	fake.updateIntegrationReturns = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIntegrationCaller) UpdateIntegrationReturnsOnCall(i int, result1 *pt.Integration, result2 *http.Response, result3 error) {
+	fake.updateIntegrationMutex.Lock()
+	defer fake.updateIntegrationMutex.Unlock()
+	fake.UpdateIntegrationStub = nil
+	if fake.updateIntegrationReturnsOnCall == nil {
+		fake.updateIntegrationReturnsOnCall = make(map[int]struct {
+			result1 *pt.Integration
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateIntegrationReturnsOnCall[i] = struct {
+		result1 *pt.Integration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIntegrationCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteIntegrationMutex.RLock()
+	defer fake.deleteIntegrationMutex.RUnlock()
+	fake.getIntegrationMutex.RLock()
+	defer fake.getIntegrationMutex.RUnlock()
+	fake.listIntegrationsMutex.RLock()
+	defer fake.listIntegrationsMutex.RUnlock()
+	fake.newIntegrationMutex.RLock()
+	defer fake.newIntegrationMutex.RUnlock()
+	fake.updateIntegrationMutex.RLock()
+	defer fake.updateIntegrationMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIntegrationCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.IntegrationCaller = new(FakeIntegrationCaller)
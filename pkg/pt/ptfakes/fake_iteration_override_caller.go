@@ -0,0 +1,293 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeIterationOverrideCaller struct {
+	GetIterationStub        func(int, int) (*pt.Iteration, *http.Response, error)
+	getIterationMutex       sync.RWMutex
+	getIterationArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getIterationReturns struct {
+		result1 *pt.Iteration
+		result2 *http.Response
+		result3 error
+	}
+	getIterationReturnsOnCall map[int]struct {
+		result1 *pt.Iteration
+		result2 *http.Response
+		result3 error
+	}
+	ListIterationOverridesStub        func(int) ([]pt.IterationOverride, *http.Response, error)
+	listIterationOverridesMutex       sync.RWMutex
+	listIterationOverridesArgsForCall []struct {
+		arg1 int
+	}
+	listIterationOverridesReturns struct {
+		result1 []pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}
+	listIterationOverridesReturnsOnCall map[int]struct {
+		result1 []pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}
+	UpdateIterationOverrideStub        func(int, int, pt.IterationOverrideRequest) (*pt.IterationOverride, *http.Response, error)
+	updateIterationOverrideMutex       sync.RWMutex
+	updateIterationOverrideArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.IterationOverrideRequest
+	}
+	updateIterationOverrideReturns struct {
+		result1 *pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}
+	updateIterationOverrideReturnsOnCall map[int]struct {
+		result1 *pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIterationOverrideCaller) GetIteration(arg1 int, arg2 int) (*pt.Iteration, *http.Response, error) {
+	fake.getIterationMutex.Lock()
+	ret, specificReturn := fake.getIterationReturnsOnCall[len(fake.getIterationArgsForCall)]
+	fake.getIterationArgsForCall = append(fake.getIterationArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetIteration", []interface{}{arg1, arg2})
+	fake.getIterationMutex.Unlock()
+	if fake.GetIterationStub != nil {
+		return fake.GetIterationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getIterationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeIterationOverrideCaller) GetIterationCallCount() int {
+	fake.getIterationMutex.RLock()
+	defer fake.getIterationMutex.RUnlock()
+	return len(fake.getIterationArgsForCall)
+}
+
+func (fake *FakeIterationOverrideCaller) GetIterationCalls(stub func(int, int) (*pt.Iteration, *http.Response, error)) {
+	fake.getIterationMutex.Lock()
+	defer fake.getIterationMutex.Unlock()
+	fake.GetIterationStub = stub
+}
+
+func (fake *FakeIterationOverrideCaller) GetIterationArgsForCall(i int) (int, int) {
+	fake.getIterationMutex.RLock()
+	defer fake.getIterationMutex.RUnlock()
+	argsForCall := fake.getIterationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIterationOverrideCaller) GetIterationReturns(result1 *pt.Iteration, result2 *http.Response, result3 error) {
+	fake.getIterationMutex.Lock()
+	defer fake.getIterationMutex.Unlock()
+	fake.GetIterationStub = nil
+	fake.getIterationReturns = struct {
+		result1 *pt.Iteration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIterationOverrideCaller) GetIterationReturnsOnCall(i int, result1 *pt.Iteration, result2 *http.Response, result3 error) {
+	fake.getIterationMutex.Lock()
+	defer fake.getIterationMutex.Unlock()
+	fake.GetIterationStub = nil
+	if fake.getIterationReturnsOnCall == nil {
+		fake.getIterationReturnsOnCall = make(map[int]struct {
+			result1 *pt.Iteration
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getIterationReturnsOnCall[i] = struct {
+		result1 *pt.Iteration
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIterationOverrideCaller) ListIterationOverrides(arg1 int) ([]pt.IterationOverride, *http.Response, error) {
+	fake.listIterationOverridesMutex.Lock()
+	ret, specificReturn := fake.listIterationOverridesReturnsOnCall[len(fake.listIterationOverridesArgsForCall)]
+	fake.listIterationOverridesArgsForCall = append(fake.listIterationOverridesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListIterationOverrides", []interface{}{arg1})
+	fake.listIterationOverridesMutex.Unlock()
+	if fake.ListIterationOverridesStub != nil {
+		return fake.ListIterationOverridesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listIterationOverridesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeIterationOverrideCaller) ListIterationOverridesCallCount() int {
+	fake.listIterationOverridesMutex.RLock()
+	defer fake.listIterationOverridesMutex.RUnlock()
+	return len(fake.listIterationOverridesArgsForCall)
+}
+
+func (fake *FakeIterationOverrideCaller) ListIterationOverridesCalls(stub func(int) ([]pt.IterationOverride, *http.Response, error)) {
+	fake.listIterationOverridesMutex.Lock()
+	defer fake.listIterationOverridesMutex.Unlock()
+	fake.ListIterationOverridesStub = stub
+}
+
+func (fake *FakeIterationOverrideCaller) ListIterationOverridesArgsForCall(i int) int {
+	fake.listIterationOverridesMutex.RLock()
+	defer fake.listIterationOverridesMutex.RUnlock()
+	argsForCall := fake.listIterationOverridesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeIterationOverrideCaller) ListIterationOverridesReturns(result1 []pt.IterationOverride, result2 *http.Response, result3 error) {
+	fake.listIterationOverridesMutex.Lock()
+	defer fake.listIterationOverridesMutex.Unlock()
+	fake.ListIterationOverridesStub = nil
+	fake.listIterationOverridesReturns = struct {
+		result1 []pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIterationOverrideCaller) ListIterationOverridesReturnsOnCall(i int, result1 []pt.IterationOverride, result2 *http.Response, result3 error) {
+	fake.listIterationOverridesMutex.Lock()
+	defer fake.listIterationOverridesMutex.Unlock()
+	fake.ListIterationOverridesStub = nil
+	if fake.listIterationOverridesReturnsOnCall == nil {
+		fake.listIterationOverridesReturnsOnCall = make(map[int]struct {
+			result1 []pt.IterationOverride
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listIterationOverridesReturnsOnCall[i] = struct {
+		result1 []pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIterationOverrideCaller) UpdateIterationOverride(arg1 int, arg2 int, arg3 pt.IterationOverrideRequest) (*pt.IterationOverride, *http.Response, error) {
+	fake.updateIterationOverrideMutex.Lock()
+	ret, specificReturn := fake.updateIterationOverrideReturnsOnCall[len(fake.updateIterationOverrideArgsForCall)]
+	fake.updateIterationOverrideArgsForCall = append(fake.updateIterationOverrideArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.IterationOverrideRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateIterationOverride", []interface{}{arg1, arg2, arg3})
+	fake.updateIterationOverrideMutex.Unlock()
+	if fake.UpdateIterationOverrideStub != nil {
+		return fake.UpdateIterationOverrideStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateIterationOverrideReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeIterationOverrideCaller) UpdateIterationOverrideCallCount() int {
+	fake.updateIterationOverrideMutex.RLock()
+	defer fake.updateIterationOverrideMutex.RUnlock()
+	return len(fake.updateIterationOverrideArgsForCall)
+}
+
+func (fake *FakeIterationOverrideCaller) UpdateIterationOverrideCalls(stub func(int, int, pt.IterationOverrideRequest) (*pt.IterationOverride, *http.Response, error)) {
+	fake.updateIterationOverrideMutex.Lock()
+	defer fake.updateIterationOverrideMutex.Unlock()
+	fake.UpdateIterationOverrideStub = stub
+}
+
+func (fake *FakeIterationOverrideCaller) UpdateIterationOverrideArgsForCall(i int) (int, int, pt.IterationOverrideRequest) {
+	fake.updateIterationOverrideMutex.RLock()
+	defer fake.updateIterationOverrideMutex.RUnlock()
+	argsForCall := fake.updateIterationOverrideArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIterationOverrideCaller) UpdateIterationOverrideReturns(result1 *pt.IterationOverride, result2 *http.Response, result3 error) {
+	fake.updateIterationOverrideMutex.Lock()
+	defer fake.updateIterationOverrideMutex.Unlock()
+	fake.UpdateIterationOverrideStub = nil
+	fake.updateIterationOverrideReturns = struct {
+		result1 *pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIterationOverrideCaller) UpdateIterationOverrideReturnsOnCall(i int, result1 *pt.IterationOverride, result2 *http.Response, result3 error) {
+	fake.updateIterationOverrideMutex.Lock()
+	defer fake.updateIterationOverrideMutex.Unlock()
+	fake.UpdateIterationOverrideStub = nil
+	if fake.updateIterationOverrideReturnsOnCall == nil {
+		fake.updateIterationOverrideReturnsOnCall = make(map[int]struct {
+			result1 *pt.IterationOverride
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateIterationOverrideReturnsOnCall[i] = struct {
+		result1 *pt.IterationOverride
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIterationOverrideCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getIterationMutex.RLock()
+	defer fake.getIterationMutex.RUnlock()
+	fake.listIterationOverridesMutex.RLock()
+	defer fake.listIterationOverridesMutex.RUnlock()
+	fake.updateIterationOverrideMutex.RLock()
+	defer fake.updateIterationOverrideMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIterationOverrideCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.IterationOverrideCaller = new(FakeIterationOverrideCaller)
@@ -0,0 +1,458 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeLabelCaller struct {
+	DeleteLabelStub        func(int, int) (*http.Response, error)
+	deleteLabelMutex       sync.RWMutex
+	deleteLabelArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteLabelReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteLabelReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetLabelStub        func(int, int) (*pt.Label, *http.Response, error)
+	getLabelMutex       sync.RWMutex
+	getLabelArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getLabelReturns struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	getLabelReturnsOnCall map[int]struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	ListLabelsStub        func(int) ([]pt.Label, *http.Response, error)
+	listLabelsMutex       sync.RWMutex
+	listLabelsArgsForCall []struct {
+		arg1 int
+	}
+	listLabelsReturns struct {
+		result1 []pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	listLabelsReturnsOnCall map[int]struct {
+		result1 []pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	NewLabelStub        func(int, pt.LabelRequest) (*pt.Label, *http.Response, error)
+	newLabelMutex       sync.RWMutex
+	newLabelArgsForCall []struct {
+		arg1 int
+		arg2 pt.LabelRequest
+	}
+	newLabelReturns struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	newLabelReturnsOnCall map[int]struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	UpdateLabelStub        func(int, int, pt.LabelRequest) (*pt.Label, *http.Response, error)
+	updateLabelMutex       sync.RWMutex
+	updateLabelArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.LabelRequest
+	}
+	updateLabelReturns struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	updateLabelReturnsOnCall map[int]struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLabelCaller) DeleteLabel(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteLabelMutex.Lock()
+	ret, specificReturn := fake.deleteLabelReturnsOnCall[len(fake.deleteLabelArgsForCall)]
+	fake.deleteLabelArgsForCall = append(fake.deleteLabelArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteLabel", []interface{}{arg1, arg2})
+	fake.deleteLabelMutex.Unlock()
+	if fake.DeleteLabelStub != nil {
+		return fake.DeleteLabelStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLabelCaller) DeleteLabelCallCount() int {
+	fake.deleteLabelMutex.RLock()
+	defer fake.deleteLabelMutex.RUnlock()
+	return len(fake.deleteLabelArgsForCall)
+}
+
+func (fake *FakeLabelCaller) DeleteLabelCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteLabelMutex.Lock()
+	defer fake.deleteLabelMutex.Unlock()
+	fake.DeleteLabelStub = stub
+}
+
+func (fake *FakeLabelCaller) DeleteLabelArgsForCall(i int) (int, int) {
+	fake.deleteLabelMutex.RLock()
+	defer fake.deleteLabelMutex.RUnlock()
+	argsForCall := fake.deleteLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLabelCaller) DeleteLabelReturns(result1 *http.Response, result2 error) {
+	fake.deleteLabelMutex.Lock()
+	defer fake.deleteLabelMutex.Unlock()
+	fake.DeleteLabelStub = nil
+	fake.deleteLabelReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLabelCaller) DeleteLabelReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteLabelMutex.Lock()
+	defer fake.deleteLabelMutex.Unlock()
+	fake.DeleteLabelStub = nil
+	if fake.deleteLabelReturnsOnCall == nil {
+		fake.deleteLabelReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteLabelReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLabelCaller) GetLabel(arg1 int, arg2 int) (*pt.Label, *http.Response, error) {
+	fake.getLabelMutex.Lock()
+	ret, specificReturn := fake.getLabelReturnsOnCall[len(fake.getLabelArgsForCall)]
+	fake.getLabelArgsForCall = append(fake.getLabelArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetLabel", []interface{}{arg1, arg2})
+	fake.getLabelMutex.Unlock()
+	if fake.GetLabelStub != nil {
+		return fake.GetLabelStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getLabelReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLabelCaller) GetLabelCallCount() int {
+	fake.getLabelMutex.RLock()
+	defer fake.getLabelMutex.RUnlock()
+	return len(fake.getLabelArgsForCall)
+}
+
+func (fake *FakeLabelCaller) GetLabelCalls(stub func(int, int) (*pt.Label, *http.Response, error)) {
+	fake.getLabelMutex.Lock()
+	defer fake.getLabelMutex.Unlock()
+	fake.GetLabelStub = stub
+}
+
+func (fake *FakeLabelCaller) GetLabelArgsForCall(i int) (int, int) {
+	fake.getLabelMutex.RLock()
+	defer fake.getLabelMutex.RUnlock()
+	argsForCall := fake.getLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLabelCaller) GetLabelReturns(result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.getLabelMutex.Lock()
+	defer fake.getLabelMutex.Unlock()
+	fake.GetLabelStub = nil
+	fake.getLabelReturns = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLabelCaller) GetLabelReturnsOnCall(i int, result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.getLabelMutex.Lock()
+	defer fake.getLabelMutex.Unlock()
+	fake.GetLabelStub = nil
+	if fake.getLabelReturnsOnCall == nil {
+		fake.getLabelReturnsOnCall = make(map[int]struct {
+			result1 *pt.Label
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getLabelReturnsOnCall[i] = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLabelCaller) ListLabels(arg1 int) ([]pt.Label, *http.Response, error) {
+	fake.listLabelsMutex.Lock()
+	ret, specificReturn := fake.listLabelsReturnsOnCall[len(fake.listLabelsArgsForCall)]
+	fake.listLabelsArgsForCall = append(fake.listLabelsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListLabels", []interface{}{arg1})
+	fake.listLabelsMutex.Unlock()
+	if fake.ListLabelsStub != nil {
+		return fake.ListLabelsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listLabelsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLabelCaller) ListLabelsCallCount() int {
+	fake.listLabelsMutex.RLock()
+	defer fake.listLabelsMutex.RUnlock()
+	return len(fake.listLabelsArgsForCall)
+}
+
+func (fake *FakeLabelCaller) ListLabelsCalls(stub func(int) ([]pt.Label, *http.Response, error)) {
+	fake.listLabelsMutex.Lock()
+	defer fake.listLabelsMutex.Unlock()
+	fake.ListLabelsStub = stub
+}
+
+func (fake *FakeLabelCaller) ListLabelsArgsForCall(i int) int {
+	fake.listLabelsMutex.RLock()
+	defer fake.listLabelsMutex.RUnlock()
+	argsForCall := fake.listLabelsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLabelCaller) ListLabelsReturns(result1 []pt.Label, result2 *http.Response, result3 error) {
+	fake.listLabelsMutex.Lock()
+	defer fake.listLabelsMutex.Unlock()
+	fake.ListLabelsStub = nil
+	fake.listLabelsReturns = struct {
+		result1 []pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLabelCaller) ListLabelsReturnsOnCall(i int, result1 []pt.Label, result2 *http.Response, result3 error) {
+	fake.listLabelsMutex.Lock()
+	defer fake.listLabelsMutex.Unlock()
+	fake.ListLabelsStub = nil
+	if fake.listLabelsReturnsOnCall == nil {
+		fake.listLabelsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Label
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listLabelsReturnsOnCall[i] = struct {
+		result1 []pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLabelCaller) NewLabel(arg1 int, arg2 pt.LabelRequest) (*pt.Label, *http.Response, error) {
+	fake.newLabelMutex.Lock()
+	ret, specificReturn := fake.newLabelReturnsOnCall[len(fake.newLabelArgsForCall)]
+	fake.newLabelArgsForCall = append(fake.newLabelArgsForCall, struct {
+		arg1 int
+		arg2 pt.LabelRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewLabel", []interface{}{arg1, arg2})
+	fake.newLabelMutex.Unlock()
+	if fake.NewLabelStub != nil {
+		return fake.NewLabelStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newLabelReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLabelCaller) NewLabelCallCount() int {
+	fake.newLabelMutex.RLock()
+	defer fake.newLabelMutex.RUnlock()
+	return len(fake.newLabelArgsForCall)
+}
+
+func (fake *FakeLabelCaller) NewLabelCalls(stub func(int, pt.LabelRequest) (*pt.Label, *http.Response, error)) {
+	fake.newLabelMutex.Lock()
+	defer fake.newLabelMutex.Unlock()
+	fake.NewLabelStub = stub
+}
+
+func (fake *FakeLabelCaller) NewLabelArgsForCall(i int) (int, pt.LabelRequest) {
+	fake.newLabelMutex.RLock()
+	defer fake.newLabelMutex.RUnlock()
+	argsForCall := fake.newLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLabelCaller) NewLabelReturns(result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.newLabelMutex.Lock()
+	defer fake.newLabelMutex.Unlock()
+	fake.NewLabelStub = nil
+	fake.newLabelReturns = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLabelCaller) NewLabelReturnsOnCall(i int, result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.newLabelMutex.Lock()
+	defer fake.newLabelMutex.Unlock()
+	fake.NewLabelStub = nil
+	if fake.newLabelReturnsOnCall == nil {
+		fake.newLabelReturnsOnCall = make(map[int]struct {
+			result1 *pt.Label
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newLabelReturnsOnCall[i] = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLabelCaller) UpdateLabel(arg1 int, arg2 int, arg3 pt.LabelRequest) (*pt.Label, *http.Response, error) {
+	fake.updateLabelMutex.Lock()
+	ret, specificReturn := fake.updateLabelReturnsOnCall[len(fake.updateLabelArgsForCall)]
+	fake.updateLabelArgsForCall = append(fake.updateLabelArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.LabelRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateLabel", []interface{}{arg1, arg2, arg3})
+	fake.updateLabelMutex.Unlock()
+	if fake.UpdateLabelStub != nil {
+		return fake.UpdateLabelStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateLabelReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLabelCaller) UpdateLabelCallCount() int {
+	fake.updateLabelMutex.RLock()
+	defer fake.updateLabelMutex.RUnlock()
+	return len(fake.updateLabelArgsForCall)
+}
+
+func (fake *FakeLabelCaller) UpdateLabelCalls(stub func(int, int, pt.LabelRequest) (*pt.Label, *http.Response, error)) {
+	fake.updateLabelMutex.Lock()
+	defer fake.updateLabelMutex.Unlock()
+	fake.UpdateLabelStub = stub
+}
+
+func (fake *FakeLabelCaller) UpdateLabelArgsForCall(i int) (int, int, pt.LabelRequest) {
+	fake.updateLabelMutex.RLock()
+	defer fake.updateLabelMutex.RUnlock()
+	argsForCall := fake.updateLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLabelCaller) UpdateLabelReturns(result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.updateLabelMutex.Lock()
+	defer fake.updateLabelMutex.Unlock()
+	fake.UpdateLabelStub = nil
+	fake.updateLabelReturns = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLabelCaller) UpdateLabelReturnsOnCall(i int, result1 *pt.Label, result2 *http.Response, result3 error) {
+	fake.updateLabelMutex.Lock()
+	defer fake.updateLabelMutex.Unlock()
+	fake.UpdateLabelStub = nil
+	if fake.updateLabelReturnsOnCall == nil {
+		fake.updateLabelReturnsOnCall = make(map[int]struct {
+			result1 *pt.Label
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateLabelReturnsOnCall[i] = struct {
+		result1 *pt.Label
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLabelCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteLabelMutex.RLock()
+	defer fake.deleteLabelMutex.RUnlock()
+	fake.getLabelMutex.RLock()
+	defer fake.getLabelMutex.RUnlock()
+	fake.listLabelsMutex.RLock()
+	defer fake.listLabelsMutex.RUnlock()
+	fake.newLabelMutex.RLock()
+	defer fake.newLabelMutex.RUnlock()
+	fake.updateLabelMutex.RLock()
+	defer fake.updateLabelMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLabelCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.LabelCaller = new(FakeLabelCaller)
@@ -0,0 +1,112 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeMeCaller struct {
+	GetMeStub        func() (*pt.Me, *http.Response, error)
+	getMeMutex       sync.RWMutex
+	getMeArgsForCall []struct {
+	}
+	getMeReturns struct {
+		result1 *pt.Me
+		result2 *http.Response
+		result3 error
+	}
+	getMeReturnsOnCall map[int]struct {
+		result1 *pt.Me
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMeCaller) GetMe() (*pt.Me, *http.Response, error) {
+	fake.getMeMutex.Lock()
+	ret, specificReturn := fake.getMeReturnsOnCall[len(fake.getMeArgsForCall)]
+	fake.getMeArgsForCall = append(fake.getMeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetMe", []interface{}{})
+	fake.getMeMutex.Unlock()
+	if fake.GetMeStub != nil {
+		return fake.GetMeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getMeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeMeCaller) GetMeCallCount() int {
+	fake.getMeMutex.RLock()
+	defer fake.getMeMutex.RUnlock()
+	return len(fake.getMeArgsForCall)
+}
+
+func (fake *FakeMeCaller) GetMeCalls(stub func() (*pt.Me, *http.Response, error)) {
+	fake.getMeMutex.Lock()
+	defer fake.getMeMutex.Unlock()
+	fake.GetMeStub = stub
+}
+
+func (fake *FakeMeCaller) GetMeReturns(result1 *pt.Me, result2 *http.Response, result3 error) {
+	fake.getMeMutex.Lock()
+	defer fake.getMeMutex.Unlock()
+	fake.GetMeStub = nil
+	fake.getMeReturns = struct {
+		result1 *pt.Me
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMeCaller) GetMeReturnsOnCall(i int, result1 *pt.Me, result2 *http.Response, result3 error) {
+	fake.getMeMutex.Lock()
+	defer fake.getMeMutex.Unlock()
+	fake.GetMeStub = nil
+	if fake.getMeReturnsOnCall == nil {
+		fake.getMeReturnsOnCall = make(map[int]struct {
+			result1 *pt.Me
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getMeReturnsOnCall[i] = struct {
+		result1 *pt.Me
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMeCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMeMutex.RLock()
+	defer fake.getMeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMeCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.MeCaller = new(FakeMeCaller)
@@ -0,0 +1,458 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeProjectMembershipCaller struct {
+	DeleteProjectMembershipStub        func(int, int) (*http.Response, error)
+	deleteProjectMembershipMutex       sync.RWMutex
+	deleteProjectMembershipArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteProjectMembershipReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteProjectMembershipReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetProjectMembershipStub        func(int, int) (*pt.ProjectMembershipResponse, *http.Response, error)
+	getProjectMembershipMutex       sync.RWMutex
+	getProjectMembershipArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getProjectMembershipReturns struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	getProjectMembershipReturnsOnCall map[int]struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	ListProjectMembershipsStub        func(int) ([]pt.ProjectMembershipResponse, *http.Response, error)
+	listProjectMembershipsMutex       sync.RWMutex
+	listProjectMembershipsArgsForCall []struct {
+		arg1 int
+	}
+	listProjectMembershipsReturns struct {
+		result1 []pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	listProjectMembershipsReturnsOnCall map[int]struct {
+		result1 []pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	NewProjectMembershipStub        func(int, pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error)
+	newProjectMembershipMutex       sync.RWMutex
+	newProjectMembershipArgsForCall []struct {
+		arg1 int
+		arg2 pt.ProjectMembershipRequest
+	}
+	newProjectMembershipReturns struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	newProjectMembershipReturnsOnCall map[int]struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	UpdateProjectMembershipStub        func(int, int, pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error)
+	updateProjectMembershipMutex       sync.RWMutex
+	updateProjectMembershipArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ProjectMembershipRequest
+	}
+	updateProjectMembershipReturns struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	updateProjectMembershipReturnsOnCall map[int]struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeProjectMembershipCaller) DeleteProjectMembership(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteProjectMembershipMutex.Lock()
+	ret, specificReturn := fake.deleteProjectMembershipReturnsOnCall[len(fake.deleteProjectMembershipArgsForCall)]
+	fake.deleteProjectMembershipArgsForCall = append(fake.deleteProjectMembershipArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteProjectMembership", []interface{}{arg1, arg2})
+	fake.deleteProjectMembershipMutex.Unlock()
+	if fake.DeleteProjectMembershipStub != nil {
+		return fake.DeleteProjectMembershipStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteProjectMembershipReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProjectMembershipCaller) DeleteProjectMembershipCallCount() int {
+	fake.deleteProjectMembershipMutex.RLock()
+	defer fake.deleteProjectMembershipMutex.RUnlock()
+	return len(fake.deleteProjectMembershipArgsForCall)
+}
+
+func (fake *FakeProjectMembershipCaller) DeleteProjectMembershipCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteProjectMembershipMutex.Lock()
+	defer fake.deleteProjectMembershipMutex.Unlock()
+	fake.DeleteProjectMembershipStub = stub
+}
+
+func (fake *FakeProjectMembershipCaller) DeleteProjectMembershipArgsForCall(i int) (int, int) {
+	fake.deleteProjectMembershipMutex.RLock()
+	defer fake.deleteProjectMembershipMutex.RUnlock()
+	argsForCall := fake.deleteProjectMembershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProjectMembershipCaller) DeleteProjectMembershipReturns(result1 *http.Response, result2 error) {
+	fake.deleteProjectMembershipMutex.Lock()
+	defer fake.deleteProjectMembershipMutex.Unlock()
+	fake.DeleteProjectMembershipStub = nil
+	fake.deleteProjectMembershipReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProjectMembershipCaller) DeleteProjectMembershipReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteProjectMembershipMutex.Lock()
+	defer fake.deleteProjectMembershipMutex.Unlock()
+	fake.DeleteProjectMembershipStub = nil
+	if fake.deleteProjectMembershipReturnsOnCall == nil {
+		fake.deleteProjectMembershipReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteProjectMembershipReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProjectMembershipCaller) GetProjectMembership(arg1 int, arg2 int) (*pt.ProjectMembershipResponse, *http.Response, error) {
+	fake.getProjectMembershipMutex.Lock()
+	ret, specificReturn := fake.getProjectMembershipReturnsOnCall[len(fake.getProjectMembershipArgsForCall)]
+	fake.getProjectMembershipArgsForCall = append(fake.getProjectMembershipArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetProjectMembership", []interface{}{arg1, arg2})
+	fake.getProjectMembershipMutex.Unlock()
+	if fake.GetProjectMembershipStub != nil {
+		return fake.GetProjectMembershipStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getProjectMembershipReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeProjectMembershipCaller) GetProjectMembershipCallCount() int {
+	fake.getProjectMembershipMutex.RLock()
+	defer fake.getProjectMembershipMutex.RUnlock()
+	return len(fake.getProjectMembershipArgsForCall)
+}
+
+func (fake *FakeProjectMembershipCaller) GetProjectMembershipCalls(stub func(int, int) (*pt.ProjectMembershipResponse, *http.Response, error)) {
+	fake.getProjectMembershipMutex.Lock()
+	defer fake.getProjectMembershipMutex.Unlock()
+	fake.GetProjectMembershipStub = stub
+}
+
+func (fake *FakeProjectMembershipCaller) GetProjectMembershipArgsForCall(i int) (int, int) {
+	fake.getProjectMembershipMutex.RLock()
+	defer fake.getProjectMembershipMutex.RUnlock()
+	argsForCall := fake.getProjectMembershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProjectMembershipCaller) GetProjectMembershipReturns(result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.getProjectMembershipMutex.Lock()
+	defer fake.getProjectMembershipMutex.Unlock()
+	fake.GetProjectMembershipStub = nil
+	fake.getProjectMembershipReturns = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProjectMembershipCaller) GetProjectMembershipReturnsOnCall(i int, result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.getProjectMembershipMutex.Lock()
+	defer fake.getProjectMembershipMutex.Unlock()
+	fake.GetProjectMembershipStub = nil
+	if fake.getProjectMembershipReturnsOnCall == nil {
+		fake.getProjectMembershipReturnsOnCall = make(map[int]struct {
+			result1 *pt.ProjectMembershipResponse
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getProjectMembershipReturnsOnCall[i] = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProjectMembershipCaller) ListProjectMemberships(arg1 int) ([]pt.ProjectMembershipResponse, *http.Response, error) {
+	fake.listProjectMembershipsMutex.Lock()
+	ret, specificReturn := fake.listProjectMembershipsReturnsOnCall[len(fake.listProjectMembershipsArgsForCall)]
+	fake.listProjectMembershipsArgsForCall = append(fake.listProjectMembershipsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListProjectMemberships", []interface{}{arg1})
+	fake.listProjectMembershipsMutex.Unlock()
+	if fake.ListProjectMembershipsStub != nil {
+		return fake.ListProjectMembershipsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listProjectMembershipsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeProjectMembershipCaller) ListProjectMembershipsCallCount() int {
+	fake.listProjectMembershipsMutex.RLock()
+	defer fake.listProjectMembershipsMutex.RUnlock()
+	return len(fake.listProjectMembershipsArgsForCall)
+}
+
+func (fake *FakeProjectMembershipCaller) ListProjectMembershipsCalls(stub func(int) ([]pt.ProjectMembershipResponse, *http.Response, error)) {
+	fake.listProjectMembershipsMutex.Lock()
+	defer fake.listProjectMembershipsMutex.Unlock()
+	fake.ListProjectMembershipsStub = stub
+}
+
+func (fake *FakeProjectMembershipCaller) ListProjectMembershipsArgsForCall(i int) int {
+	fake.listProjectMembershipsMutex.RLock()
+	defer fake.listProjectMembershipsMutex.RUnlock()
+	argsForCall := fake.listProjectMembershipsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProjectMembershipCaller) ListProjectMembershipsReturns(result1 []pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.listProjectMembershipsMutex.Lock()
+	defer fake.listProjectMembershipsMutex.Unlock()
+	fake.ListProjectMembershipsStub = nil
+	fake.listProjectMembershipsReturns = struct {
+		result1 []pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProjectMembershipCaller) ListProjectMembershipsReturnsOnCall(i int, result1 []pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.listProjectMembershipsMutex.Lock()
+	defer fake.listProjectMembershipsMutex.Unlock()
+	fake.ListProjectMembershipsStub = nil
+	if fake.listProjectMembershipsReturnsOnCall == nil {
+		fake.listProjectMembershipsReturnsOnCall = make(map[int]struct {
+			result1 []pt.ProjectMembershipResponse
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listProjectMembershipsReturnsOnCall[i] = struct {
+		result1 []pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProjectMembershipCaller) NewProjectMembership(arg1 int, arg2 pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error) {
+	fake.newProjectMembershipMutex.Lock()
+	ret, specificReturn := fake.newProjectMembershipReturnsOnCall[len(fake.newProjectMembershipArgsForCall)]
+	fake.newProjectMembershipArgsForCall = append(fake.newProjectMembershipArgsForCall, struct {
+		arg1 int
+		arg2 pt.ProjectMembershipRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewProjectMembership", []interface{}{arg1, arg2})
+	fake.newProjectMembershipMutex.Unlock()
+	if fake.NewProjectMembershipStub != nil {
+		return fake.NewProjectMembershipStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newProjectMembershipReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeProjectMembershipCaller) NewProjectMembershipCallCount() int {
+	fake.newProjectMembershipMutex.RLock()
+	defer fake.newProjectMembershipMutex.RUnlock()
+	return len(fake.newProjectMembershipArgsForCall)
+}
+
+func (fake *FakeProjectMembershipCaller) NewProjectMembershipCalls(stub func(int, pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error)) {
+	fake.newProjectMembershipMutex.Lock()
+	defer fake.newProjectMembershipMutex.Unlock()
+	fake.NewProjectMembershipStub = stub
+}
+
+func (fake *FakeProjectMembershipCaller) NewProjectMembershipArgsForCall(i int) (int, pt.ProjectMembershipRequest) {
+	fake.newProjectMembershipMutex.RLock()
+	defer fake.newProjectMembershipMutex.RUnlock()
+	argsForCall := fake.newProjectMembershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProjectMembershipCaller) NewProjectMembershipReturns(result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.newProjectMembershipMutex.Lock()
+	defer fake.newProjectMembershipMutex.Unlock()
+	fake.NewProjectMembershipStub = nil
+	fake.newProjectMembershipReturns = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProjectMembershipCaller) NewProjectMembershipReturnsOnCall(i int, result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.newProjectMembershipMutex.Lock()
+	defer fake.newProjectMembershipMutex.Unlock()
+	fake.NewProjectMembershipStub = nil
+	if fake.newProjectMembershipReturnsOnCall == nil {
+		fake.newProjectMembershipReturnsOnCall = make(map[int]struct {
+			result1 *pt.ProjectMembershipResponse
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newProjectMembershipReturnsOnCall[i] = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProjectMembershipCaller) UpdateProjectMembership(arg1 int, arg2 int, arg3 pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error) {
+	fake.updateProjectMembershipMutex.Lock()
+	ret, specificReturn := fake.updateProjectMembershipReturnsOnCall[len(fake.updateProjectMembershipArgsForCall)]
+	fake.updateProjectMembershipArgsForCall = append(fake.updateProjectMembershipArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ProjectMembershipRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateProjectMembership", []interface{}{arg1, arg2, arg3})
+	fake.updateProjectMembershipMutex.Unlock()
+	if fake.UpdateProjectMembershipStub != nil {
+		return fake.UpdateProjectMembershipStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateProjectMembershipReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeProjectMembershipCaller) UpdateProjectMembershipCallCount() int {
+	fake.updateProjectMembershipMutex.RLock()
+	defer fake.updateProjectMembershipMutex.RUnlock()
+	return len(fake.updateProjectMembershipArgsForCall)
+}
+
+func (fake *FakeProjectMembershipCaller) UpdateProjectMembershipCalls(stub func(int, int, pt.ProjectMembershipRequest) (*pt.ProjectMembershipResponse, *http.Response, error)) {
+	fake.updateProjectMembershipMutex.Lock()
+	defer fake.updateProjectMembershipMutex.Unlock()
+	fake.UpdateProjectMembershipStub = stub
+}
+
+func (fake *FakeProjectMembershipCaller) UpdateProjectMembershipArgsForCall(i int) (int, int, pt.ProjectMembershipRequest) {
+	fake.updateProjectMembershipMutex.RLock()
+	defer fake.updateProjectMembershipMutex.RUnlock()
+	argsForCall := fake.updateProjectMembershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProjectMembershipCaller) UpdateProjectMembershipReturns(result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.updateProjectMembershipMutex.Lock()
+	defer fake.updateProjectMembershipMutex.Unlock()
+	fake.UpdateProjectMembershipStub = nil
+	fake.updateProjectMembershipReturns = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProjectMembershipCaller) UpdateProjectMembershipReturnsOnCall(i int, result1 *pt.ProjectMembershipResponse, result2 *http.Response, result3 error) {
+	fake.updateProjectMembershipMutex.Lock()
+	defer fake.updateProjectMembershipMutex.Unlock()
+	fake.UpdateProjectMembershipStub = nil
+	if fake.updateProjectMembershipReturnsOnCall == nil {
+		fake.updateProjectMembershipReturnsOnCall = make(map[int]struct {
+			result1 *pt.ProjectMembershipResponse
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateProjectMembershipReturnsOnCall[i] = struct {
+		result1 *pt.ProjectMembershipResponse
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProjectMembershipCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteProjectMembershipMutex.RLock()
+	defer fake.deleteProjectMembershipMutex.RUnlock()
+	fake.getProjectMembershipMutex.RLock()
+	defer fake.getProjectMembershipMutex.RUnlock()
+	fake.listProjectMembershipsMutex.RLock()
+	defer fake.listProjectMembershipsMutex.RUnlock()
+	fake.newProjectMembershipMutex.RLock()
+	defer fake.newProjectMembershipMutex.RUnlock()
+	fake.updateProjectMembershipMutex.RLock()
+	defer fake.updateProjectMembershipMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeProjectMembershipCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.ProjectMembershipCaller = new(FakeProjectMembershipCaller)
@@ -0,0 +1,468 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeReviewCaller struct {
+	DeleteReviewStub        func(int, int, int) (*http.Response, error)
+	deleteReviewMutex       sync.RWMutex
+	deleteReviewArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}
+	deleteReviewReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteReviewReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetReviewStub        func(int, int, int) (*pt.Review, *http.Response, error)
+	getReviewMutex       sync.RWMutex
+	getReviewArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}
+	getReviewReturns struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	getReviewReturnsOnCall map[int]struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	ListReviewsStub        func(int, int) ([]pt.Review, *http.Response, error)
+	listReviewsMutex       sync.RWMutex
+	listReviewsArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	listReviewsReturns struct {
+		result1 []pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	listReviewsReturnsOnCall map[int]struct {
+		result1 []pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	NewReviewStub        func(int, int, pt.ReviewRequest) (*pt.Review, *http.Response, error)
+	newReviewMutex       sync.RWMutex
+	newReviewArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ReviewRequest
+	}
+	newReviewReturns struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	newReviewReturnsOnCall map[int]struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	UpdateReviewStub        func(int, int, int, pt.ReviewRequest) (*pt.Review, *http.Response, error)
+	updateReviewMutex       sync.RWMutex
+	updateReviewArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+		arg4 pt.ReviewRequest
+	}
+	updateReviewReturns struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	updateReviewReturnsOnCall map[int]struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReviewCaller) DeleteReview(arg1 int, arg2 int, arg3 int) (*http.Response, error) {
+	fake.deleteReviewMutex.Lock()
+	ret, specificReturn := fake.deleteReviewReturnsOnCall[len(fake.deleteReviewArgsForCall)]
+	fake.deleteReviewArgsForCall = append(fake.deleteReviewArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteReview", []interface{}{arg1, arg2, arg3})
+	fake.deleteReviewMutex.Unlock()
+	if fake.DeleteReviewStub != nil {
+		return fake.DeleteReviewStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteReviewReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReviewCaller) DeleteReviewCallCount() int {
+	fake.deleteReviewMutex.RLock()
+	defer fake.deleteReviewMutex.RUnlock()
+	return len(fake.deleteReviewArgsForCall)
+}
+
+func (fake *FakeReviewCaller) DeleteReviewCalls(stub func(int, int, int) (*http.Response, error)) {
+	fake.deleteReviewMutex.Lock()
+	defer fake.deleteReviewMutex.Unlock()
+	fake.DeleteReviewStub = stub
+}
+
+func (fake *FakeReviewCaller) DeleteReviewArgsForCall(i int) (int, int, int) {
+	fake.deleteReviewMutex.RLock()
+	defer fake.deleteReviewMutex.RUnlock()
+	argsForCall := fake.deleteReviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReviewCaller) DeleteReviewReturns(result1 *http.Response, result2 error) {
+	fake.deleteReviewMutex.Lock()
+	defer fake.deleteReviewMutex.Unlock()
+	fake.DeleteReviewStub = nil
+	fake.deleteReviewReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReviewCaller) DeleteReviewReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteReviewMutex.Lock()
+	defer fake.deleteReviewMutex.Unlock()
+	fake.DeleteReviewStub = nil
+	if fake.deleteReviewReturnsOnCall == nil {
+		fake.deleteReviewReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteReviewReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReviewCaller) GetReview(arg1 int, arg2 int, arg3 int) (*pt.Review, *http.Response, error) {
+	fake.getReviewMutex.Lock()
+	ret, specificReturn := fake.getReviewReturnsOnCall[len(fake.getReviewArgsForCall)]
+	fake.getReviewArgsForCall = append(fake.getReviewArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetReview", []interface{}{arg1, arg2, arg3})
+	fake.getReviewMutex.Unlock()
+	if fake.GetReviewStub != nil {
+		return fake.GetReviewStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReviewReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeReviewCaller) GetReviewCallCount() int {
+	fake.getReviewMutex.RLock()
+	defer fake.getReviewMutex.RUnlock()
+	return len(fake.getReviewArgsForCall)
+}
+
+func (fake *FakeReviewCaller) GetReviewCalls(stub func(int, int, int) (*pt.Review, *http.Response, error)) {
+	fake.getReviewMutex.Lock()
+	defer fake.getReviewMutex.Unlock()
+	fake.GetReviewStub = stub
+}
+
+func (fake *FakeReviewCaller) GetReviewArgsForCall(i int) (int, int, int) {
+	fake.getReviewMutex.RLock()
+	defer fake.getReviewMutex.RUnlock()
+	argsForCall := fake.getReviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReviewCaller) GetReviewReturns(result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.getReviewMutex.Lock()
+	defer fake.getReviewMutex.Unlock()
+	fake.GetReviewStub = nil
+	fake.getReviewReturns = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewCaller) GetReviewReturnsOnCall(i int, result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.getReviewMutex.Lock()
+	defer fake.getReviewMutex.Unlock()
+	fake.GetReviewStub = nil
+	if fake.getReviewReturnsOnCall == nil {
+		fake.getReviewReturnsOnCall = make(map[int]struct {
+			result1 *pt.Review
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getReviewReturnsOnCall[i] = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewCaller) ListReviews(arg1 int, arg2 int) ([]pt.Review, *http.Response, error) {
+	fake.listReviewsMutex.Lock()
+	ret, specificReturn := fake.listReviewsReturnsOnCall[len(fake.listReviewsArgsForCall)]
+	fake.listReviewsArgsForCall = append(fake.listReviewsArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("ListReviews", []interface{}{arg1, arg2})
+	fake.listReviewsMutex.Unlock()
+	if fake.ListReviewsStub != nil {
+		return fake.ListReviewsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listReviewsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeReviewCaller) ListReviewsCallCount() int {
+	fake.listReviewsMutex.RLock()
+	defer fake.listReviewsMutex.RUnlock()
+	return len(fake.listReviewsArgsForCall)
+}
+
+func (fake *FakeReviewCaller) ListReviewsCalls(stub func(int, int) ([]pt.Review, *http.Response, error)) {
+	fake.listReviewsMutex.Lock()
+	defer fake.listReviewsMutex.Unlock()
+	fake.ListReviewsStub = stub
+}
+
+func (fake *FakeReviewCaller) ListReviewsArgsForCall(i int) (int, int) {
+	fake.listReviewsMutex.RLock()
+	defer fake.listReviewsMutex.RUnlock()
+	argsForCall := fake.listReviewsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReviewCaller) ListReviewsReturns(result1 []pt.Review, result2 *http.Response, result3 error) {
+	fake.listReviewsMutex.Lock()
+	defer fake.listReviewsMutex.Unlock()
+	fake.ListReviewsStub = nil
+	fake.listReviewsReturns = struct {
+		result1 []pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewCaller) ListReviewsReturnsOnCall(i int, result1 []pt.Review, result2 *http.Response, result3 error) {
+	fake.listReviewsMutex.Lock()
+	defer fake.listReviewsMutex.Unlock()
+	fake.ListReviewsStub = nil
+	if fake.listReviewsReturnsOnCall == nil {
+		fake.listReviewsReturnsOnCall = make(map[int]struct {
+			result1 []pt.Review
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listReviewsReturnsOnCall[i] = struct {
+		result1 []pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewCaller) NewReview(arg1 int, arg2 int, arg3 pt.ReviewRequest) (*pt.Review, *http.Response, error) {
+	fake.newReviewMutex.Lock()
+	ret, specificReturn := fake.newReviewReturnsOnCall[len(fake.newReviewArgsForCall)]
+	fake.newReviewArgsForCall = append(fake.newReviewArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ReviewRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("NewReview", []interface{}{arg1, arg2, arg3})
+	fake.newReviewMutex.Unlock()
+	if fake.NewReviewStub != nil {
+		return fake.NewReviewStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newReviewReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeReviewCaller) NewReviewCallCount() int {
+	fake.newReviewMutex.RLock()
+	defer fake.newReviewMutex.RUnlock()
+	return len(fake.newReviewArgsForCall)
+}
+
+func (fake *FakeReviewCaller) NewReviewCalls(stub func(int, int, pt.ReviewRequest) (*pt.Review, *http.Response, error)) {
+	fake.newReviewMutex.Lock()
+	defer fake.newReviewMutex.Unlock()
+	fake.NewReviewStub = stub
+}
+
+func (fake *FakeReviewCaller) NewReviewArgsForCall(i int) (int, int, pt.ReviewRequest) {
+	fake.newReviewMutex.RLock()
+	defer fake.newReviewMutex.RUnlock()
+	argsForCall := fake.newReviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReviewCaller) NewReviewReturns(result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.newReviewMutex.Lock()
+	defer fake.newReviewMutex.Unlock()
+	fake.NewReviewStub = nil
+	fake.newReviewReturns = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewCaller) NewReviewReturnsOnCall(i int, result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.newReviewMutex.Lock()
+	defer fake.newReviewMutex.Unlock()
+	fake.NewReviewStub = nil
+	if fake.newReviewReturnsOnCall == nil {
+		fake.newReviewReturnsOnCall = make(map[int]struct {
+			result1 *pt.Review
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newReviewReturnsOnCall[i] = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewCaller) UpdateReview(arg1 int, arg2 int, arg3 int, arg4 pt.ReviewRequest) (*pt.Review, *http.Response, error) {
+	fake.updateReviewMutex.Lock()
+	ret, specificReturn := fake.updateReviewReturnsOnCall[len(fake.updateReviewArgsForCall)]
+	fake.updateReviewArgsForCall = append(fake.updateReviewArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+		arg4 pt.ReviewRequest
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateReview", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateReviewMutex.Unlock()
+	if fake.UpdateReviewStub != nil {
+		return fake.UpdateReviewStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateReviewReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeReviewCaller) UpdateReviewCallCount() int {
+	fake.updateReviewMutex.RLock()
+	defer fake.updateReviewMutex.RUnlock()
+	return len(fake.updateReviewArgsForCall)
+}
+
+func (fake *FakeReviewCaller) UpdateReviewCalls(stub func(int, int, int, pt.ReviewRequest) (*pt.Review, *http.Response, error)) {
+	fake.updateReviewMutex.Lock()
+	defer fake.updateReviewMutex.Unlock()
+	fake.UpdateReviewStub = stub
+}
+
+func (fake *FakeReviewCaller) UpdateReviewArgsForCall(i int) (int, int, int, pt.ReviewRequest) {
+	fake.updateReviewMutex.RLock()
+	defer fake.updateReviewMutex.RUnlock()
+	argsForCall := fake.updateReviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeReviewCaller) UpdateReviewReturns(result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.updateReviewMutex.Lock()
+	defer fake.updateReviewMutex.Unlock()
+	fake.UpdateReviewStub = nil
+	fake.updateReviewReturns = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewCaller) UpdateReviewReturnsOnCall(i int, result1 *pt.Review, result2 *http.Response, result3 error) {
+	fake.updateReviewMutex.Lock()
+	defer fake.updateReviewMutex.Unlock()
+	fake.UpdateReviewStub = nil
+	if fake.updateReviewReturnsOnCall == nil {
+		fake.updateReviewReturnsOnCall = make(map[int]struct {
+			result1 *pt.Review
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateReviewReturnsOnCall[i] = struct {
+		result1 *pt.Review
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteReviewMutex.RLock()
+	defer fake.deleteReviewMutex.RUnlock()
+	fake.getReviewMutex.RLock()
+	defer fake.getReviewMutex.RUnlock()
+	fake.listReviewsMutex.RLock()
+	defer fake.listReviewsMutex.RUnlock()
+	fake.newReviewMutex.RLock()
+	defer fake.newReviewMutex.RUnlock()
+	fake.updateReviewMutex.RLock()
+	defer fake.updateReviewMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeReviewCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.ReviewCaller = new(FakeReviewCaller)
@@ -0,0 +1,458 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeReviewTypeCaller struct {
+	DeleteReviewTypeStub        func(int, int) (*http.Response, error)
+	deleteReviewTypeMutex       sync.RWMutex
+	deleteReviewTypeArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteReviewTypeReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteReviewTypeReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetReviewTypeStub        func(int, int) (*pt.ReviewType, *http.Response, error)
+	getReviewTypeMutex       sync.RWMutex
+	getReviewTypeArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getReviewTypeReturns struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	getReviewTypeReturnsOnCall map[int]struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	ListReviewTypesStub        func(int) ([]pt.ReviewType, *http.Response, error)
+	listReviewTypesMutex       sync.RWMutex
+	listReviewTypesArgsForCall []struct {
+		arg1 int
+	}
+	listReviewTypesReturns struct {
+		result1 []pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	listReviewTypesReturnsOnCall map[int]struct {
+		result1 []pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	NewReviewTypeStub        func(int, pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error)
+	newReviewTypeMutex       sync.RWMutex
+	newReviewTypeArgsForCall []struct {
+		arg1 int
+		arg2 pt.ReviewTypeRequest
+	}
+	newReviewTypeReturns struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	newReviewTypeReturnsOnCall map[int]struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	UpdateReviewTypeStub        func(int, int, pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error)
+	updateReviewTypeMutex       sync.RWMutex
+	updateReviewTypeArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ReviewTypeRequest
+	}
+	updateReviewTypeReturns struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	updateReviewTypeReturnsOnCall map[int]struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReviewTypeCaller) DeleteReviewType(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteReviewTypeMutex.Lock()
+	ret, specificReturn := fake.deleteReviewTypeReturnsOnCall[len(fake.deleteReviewTypeArgsForCall)]
+	fake.deleteReviewTypeArgsForCall = append(fake.deleteReviewTypeArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteReviewType", []interface{}{arg1, arg2})
+	fake.deleteReviewTypeMutex.Unlock()
+	if fake.DeleteReviewTypeStub != nil {
+		return fake.DeleteReviewTypeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteReviewTypeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReviewTypeCaller) DeleteReviewTypeCallCount() int {
+	fake.deleteReviewTypeMutex.RLock()
+	defer fake.deleteReviewTypeMutex.RUnlock()
+	return len(fake.deleteReviewTypeArgsForCall)
+}
+
+func (fake *FakeReviewTypeCaller) DeleteReviewTypeCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteReviewTypeMutex.Lock()
+	defer fake.deleteReviewTypeMutex.Unlock()
+	fake.DeleteReviewTypeStub = stub
+}
+
+func (fake *FakeReviewTypeCaller) DeleteReviewTypeArgsForCall(i int) (int, int) {
+	fake.deleteReviewTypeMutex.RLock()
+	defer fake.deleteReviewTypeMutex.RUnlock()
+	argsForCall := fake.deleteReviewTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReviewTypeCaller) DeleteReviewTypeReturns(result1 *http.Response, result2 error) {
+	fake.deleteReviewTypeMutex.Lock()
+	defer fake.deleteReviewTypeMutex.Unlock()
+	fake.DeleteReviewTypeStub = nil
+	fake.deleteReviewTypeReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReviewTypeCaller) DeleteReviewTypeReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteReviewTypeMutex.Lock()
+	defer fake.deleteReviewTypeMutex.Unlock()
+	fake.DeleteReviewTypeStub = nil
+	if fake.deleteReviewTypeReturnsOnCall == nil {
+		fake.deleteReviewTypeReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteReviewTypeReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReviewTypeCaller) GetReviewType(arg1 int, arg2 int) (*pt.ReviewType, *http.Response, error) {
+	fake.getReviewTypeMutex.Lock()
+	ret, specificReturn := fake.getReviewTypeReturnsOnCall[len(fake.getReviewTypeArgsForCall)]
+	fake.getReviewTypeArgsForCall = append(fake.getReviewTypeArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetReviewType", []interface{}{arg1, arg2})
+	fake.getReviewTypeMutex.Unlock()
+	if fake.GetReviewTypeStub != nil {
+		return fake.GetReviewTypeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReviewTypeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeReviewTypeCaller) GetReviewTypeCallCount() int {
+	fake.getReviewTypeMutex.RLock()
+	defer fake.getReviewTypeMutex.RUnlock()
+	return len(fake.getReviewTypeArgsForCall)
+}
+
+func (fake *FakeReviewTypeCaller) GetReviewTypeCalls(stub func(int, int) (*pt.ReviewType, *http.Response, error)) {
+	fake.getReviewTypeMutex.Lock()
+	defer fake.getReviewTypeMutex.Unlock()
+	fake.GetReviewTypeStub = stub
+}
+
+func (fake *FakeReviewTypeCaller) GetReviewTypeArgsForCall(i int) (int, int) {
+	fake.getReviewTypeMutex.RLock()
+	defer fake.getReviewTypeMutex.RUnlock()
+	argsForCall := fake.getReviewTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReviewTypeCaller) GetReviewTypeReturns(result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.getReviewTypeMutex.Lock()
+	defer fake.getReviewTypeMutex.Unlock()
+	fake.GetReviewTypeStub = nil
+	fake.getReviewTypeReturns = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewTypeCaller) GetReviewTypeReturnsOnCall(i int, result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.getReviewTypeMutex.Lock()
+	defer fake.getReviewTypeMutex.Unlock()
+	fake.GetReviewTypeStub = nil
+	if fake.getReviewTypeReturnsOnCall == nil {
+		fake.getReviewTypeReturnsOnCall = make(map[int]struct {
+			result1 *pt.ReviewType
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getReviewTypeReturnsOnCall[i] = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewTypeCaller) ListReviewTypes(arg1 int) ([]pt.ReviewType, *http.Response, error) {
+	fake.listReviewTypesMutex.Lock()
+	ret, specificReturn := fake.listReviewTypesReturnsOnCall[len(fake.listReviewTypesArgsForCall)]
+	fake.listReviewTypesArgsForCall = append(fake.listReviewTypesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListReviewTypes", []interface{}{arg1})
+	fake.listReviewTypesMutex.Unlock()
+	if fake.ListReviewTypesStub != nil {
+		return fake.ListReviewTypesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listReviewTypesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeReviewTypeCaller) ListReviewTypesCallCount() int {
+	fake.listReviewTypesMutex.RLock()
+	defer fake.listReviewTypesMutex.RUnlock()
+	return len(fake.listReviewTypesArgsForCall)
+}
+
+func (fake *FakeReviewTypeCaller) ListReviewTypesCalls(stub func(int) ([]pt.ReviewType, *http.Response, error)) {
+	fake.listReviewTypesMutex.Lock()
+	defer fake.listReviewTypesMutex.Unlock()
+	fake.ListReviewTypesStub = stub
+}
+
+func (fake *FakeReviewTypeCaller) ListReviewTypesArgsForCall(i int) int {
+	fake.listReviewTypesMutex.RLock()
+	defer fake.listReviewTypesMutex.RUnlock()
+	argsForCall := fake.listReviewTypesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReviewTypeCaller) ListReviewTypesReturns(result1 []pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.listReviewTypesMutex.Lock()
+	defer fake.listReviewTypesMutex.Unlock()
+	fake.ListReviewTypesStub = nil
+	fake.listReviewTypesReturns = struct {
+		result1 []pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewTypeCaller) ListReviewTypesReturnsOnCall(i int, result1 []pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.listReviewTypesMutex.Lock()
+	defer fake.listReviewTypesMutex.Unlock()
+	fake.ListReviewTypesStub = nil
+	if fake.listReviewTypesReturnsOnCall == nil {
+		fake.listReviewTypesReturnsOnCall = make(map[int]struct {
+			result1 []pt.ReviewType
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listReviewTypesReturnsOnCall[i] = struct {
+		result1 []pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewTypeCaller) NewReviewType(arg1 int, arg2 pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error) {
+	fake.newReviewTypeMutex.Lock()
+	ret, specificReturn := fake.newReviewTypeReturnsOnCall[len(fake.newReviewTypeArgsForCall)]
+	fake.newReviewTypeArgsForCall = append(fake.newReviewTypeArgsForCall, struct {
+		arg1 int
+		arg2 pt.ReviewTypeRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewReviewType", []interface{}{arg1, arg2})
+	fake.newReviewTypeMutex.Unlock()
+	if fake.NewReviewTypeStub != nil {
+		return fake.NewReviewTypeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newReviewTypeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeReviewTypeCaller) NewReviewTypeCallCount() int {
+	fake.newReviewTypeMutex.RLock()
+	defer fake.newReviewTypeMutex.RUnlock()
+	return len(fake.newReviewTypeArgsForCall)
+}
+
+func (fake *FakeReviewTypeCaller) NewReviewTypeCalls(stub func(int, pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error)) {
+	fake.newReviewTypeMutex.Lock()
+	defer fake.newReviewTypeMutex.Unlock()
+	fake.NewReviewTypeStub = stub
+}
+
+func (fake *FakeReviewTypeCaller) NewReviewTypeArgsForCall(i int) (int, pt.ReviewTypeRequest) {
+	fake.newReviewTypeMutex.RLock()
+	defer fake.newReviewTypeMutex.RUnlock()
+	argsForCall := fake.newReviewTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReviewTypeCaller) NewReviewTypeReturns(result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.newReviewTypeMutex.Lock()
+	defer fake.newReviewTypeMutex.Unlock()
+	fake.NewReviewTypeStub = nil
+	fake.newReviewTypeReturns = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewTypeCaller) NewReviewTypeReturnsOnCall(i int, result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.newReviewTypeMutex.Lock()
+	defer fake.newReviewTypeMutex.Unlock()
+	fake.NewReviewTypeStub = nil
+	if fake.newReviewTypeReturnsOnCall == nil {
+		fake.newReviewTypeReturnsOnCall = make(map[int]struct {
+			result1 *pt.ReviewType
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newReviewTypeReturnsOnCall[i] = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewTypeCaller) UpdateReviewType(arg1 int, arg2 int, arg3 pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error) {
+	fake.updateReviewTypeMutex.Lock()
+	ret, specificReturn := fake.updateReviewTypeReturnsOnCall[len(fake.updateReviewTypeArgsForCall)]
+	fake.updateReviewTypeArgsForCall = append(fake.updateReviewTypeArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.ReviewTypeRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateReviewType", []interface{}{arg1, arg2, arg3})
+	fake.updateReviewTypeMutex.Unlock()
+	if fake.UpdateReviewTypeStub != nil {
+		return fake.UpdateReviewTypeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateReviewTypeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeReviewTypeCaller) UpdateReviewTypeCallCount() int {
+	fake.updateReviewTypeMutex.RLock()
+	defer fake.updateReviewTypeMutex.RUnlock()
+	return len(fake.updateReviewTypeArgsForCall)
+}
+
+func (fake *FakeReviewTypeCaller) UpdateReviewTypeCalls(stub func(int, int, pt.ReviewTypeRequest) (*pt.ReviewType, *http.Response, error)) {
+	fake.updateReviewTypeMutex.Lock()
+	defer fake.updateReviewTypeMutex.Unlock()
+	fake.UpdateReviewTypeStub = stub
+}
+
+func (fake *FakeReviewTypeCaller) UpdateReviewTypeArgsForCall(i int) (int, int, pt.ReviewTypeRequest) {
+	fake.updateReviewTypeMutex.RLock()
+	defer fake.updateReviewTypeMutex.RUnlock()
+	argsForCall := fake.updateReviewTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReviewTypeCaller) UpdateReviewTypeReturns(result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.updateReviewTypeMutex.Lock()
+	defer fake.updateReviewTypeMutex.Unlock()
+	fake.UpdateReviewTypeStub = nil
+	fake.updateReviewTypeReturns = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewTypeCaller) UpdateReviewTypeReturnsOnCall(i int, result1 *pt.ReviewType, result2 *http.Response, result3 error) {
+	fake.updateReviewTypeMutex.Lock()
+	defer fake.updateReviewTypeMutex.Unlock()
+	fake.UpdateReviewTypeStub = nil
+	if fake.updateReviewTypeReturnsOnCall == nil {
+		fake.updateReviewTypeReturnsOnCall = make(map[int]struct {
+			result1 *pt.ReviewType
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateReviewTypeReturnsOnCall[i] = struct {
+		result1 *pt.ReviewType
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReviewTypeCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteReviewTypeMutex.RLock()
+	defer fake.deleteReviewTypeMutex.RUnlock()
+	fake.getReviewTypeMutex.RLock()
+	defer fake.getReviewTypeMutex.RUnlock()
+	fake.listReviewTypesMutex.RLock()
+	defer fake.listReviewTypesMutex.RUnlock()
+	fake.newReviewTypeMutex.RLock()
+	defer fake.newReviewTypeMutex.RUnlock()
+	fake.updateReviewTypeMutex.RLock()
+	defer fake.updateReviewTypeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeReviewTypeCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.ReviewTypeCaller = new(FakeReviewTypeCaller)
@@ -0,0 +1,458 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeStoryCaller struct {
+	DeleteStoryStub        func(int, int) (*http.Response, error)
+	deleteStoryMutex       sync.RWMutex
+	deleteStoryArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteStoryReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteStoryReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetStoryStub        func(int, int) (*pt.Story, *http.Response, error)
+	getStoryMutex       sync.RWMutex
+	getStoryArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getStoryReturns struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	getStoryReturnsOnCall map[int]struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	ListStoriesStub        func(int) ([]pt.Story, *http.Response, error)
+	listStoriesMutex       sync.RWMutex
+	listStoriesArgsForCall []struct {
+		arg1 int
+	}
+	listStoriesReturns struct {
+		result1 []pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	listStoriesReturnsOnCall map[int]struct {
+		result1 []pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	NewStoryStub        func(int, pt.StoryRequest) (*pt.Story, *http.Response, error)
+	newStoryMutex       sync.RWMutex
+	newStoryArgsForCall []struct {
+		arg1 int
+		arg2 pt.StoryRequest
+	}
+	newStoryReturns struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	newStoryReturnsOnCall map[int]struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	UpdateStoryStub        func(int, int, pt.StoryRequest) (*pt.Story, *http.Response, error)
+	updateStoryMutex       sync.RWMutex
+	updateStoryArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.StoryRequest
+	}
+	updateStoryReturns struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	updateStoryReturnsOnCall map[int]struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStoryCaller) DeleteStory(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteStoryMutex.Lock()
+	ret, specificReturn := fake.deleteStoryReturnsOnCall[len(fake.deleteStoryArgsForCall)]
+	fake.deleteStoryArgsForCall = append(fake.deleteStoryArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteStory", []interface{}{arg1, arg2})
+	fake.deleteStoryMutex.Unlock()
+	if fake.DeleteStoryStub != nil {
+		return fake.DeleteStoryStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteStoryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoryCaller) DeleteStoryCallCount() int {
+	fake.deleteStoryMutex.RLock()
+	defer fake.deleteStoryMutex.RUnlock()
+	return len(fake.deleteStoryArgsForCall)
+}
+
+func (fake *FakeStoryCaller) DeleteStoryCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteStoryMutex.Lock()
+	defer fake.deleteStoryMutex.Unlock()
+	fake.DeleteStoryStub = stub
+}
+
+func (fake *FakeStoryCaller) DeleteStoryArgsForCall(i int) (int, int) {
+	fake.deleteStoryMutex.RLock()
+	defer fake.deleteStoryMutex.RUnlock()
+	argsForCall := fake.deleteStoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoryCaller) DeleteStoryReturns(result1 *http.Response, result2 error) {
+	fake.deleteStoryMutex.Lock()
+	defer fake.deleteStoryMutex.Unlock()
+	fake.DeleteStoryStub = nil
+	fake.deleteStoryReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoryCaller) DeleteStoryReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteStoryMutex.Lock()
+	defer fake.deleteStoryMutex.Unlock()
+	fake.DeleteStoryStub = nil
+	if fake.deleteStoryReturnsOnCall == nil {
+		fake.deleteStoryReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteStoryReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoryCaller) GetStory(arg1 int, arg2 int) (*pt.Story, *http.Response, error) {
+	fake.getStoryMutex.Lock()
+	ret, specificReturn := fake.getStoryReturnsOnCall[len(fake.getStoryArgsForCall)]
+	fake.getStoryArgsForCall = append(fake.getStoryArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetStory", []interface{}{arg1, arg2})
+	fake.getStoryMutex.Unlock()
+	if fake.GetStoryStub != nil {
+		return fake.GetStoryStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getStoryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStoryCaller) GetStoryCallCount() int {
+	fake.getStoryMutex.RLock()
+	defer fake.getStoryMutex.RUnlock()
+	return len(fake.getStoryArgsForCall)
+}
+
+func (fake *FakeStoryCaller) GetStoryCalls(stub func(int, int) (*pt.Story, *http.Response, error)) {
+	fake.getStoryMutex.Lock()
+	defer fake.getStoryMutex.Unlock()
+	fake.GetStoryStub = stub
+}
+
+func (fake *FakeStoryCaller) GetStoryArgsForCall(i int) (int, int) {
+	fake.getStoryMutex.RLock()
+	defer fake.getStoryMutex.RUnlock()
+	argsForCall := fake.getStoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoryCaller) GetStoryReturns(result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.getStoryMutex.Lock()
+	defer fake.getStoryMutex.Unlock()
+	fake.GetStoryStub = nil
+	fake.getStoryReturns = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoryCaller) GetStoryReturnsOnCall(i int, result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.getStoryMutex.Lock()
+	defer fake.getStoryMutex.Unlock()
+	fake.GetStoryStub = nil
+	if fake.getStoryReturnsOnCall == nil {
+		fake.getStoryReturnsOnCall = make(map[int]struct {
+			result1 *pt.Story
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getStoryReturnsOnCall[i] = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoryCaller) ListStories(arg1 int) ([]pt.Story, *http.Response, error) {
+	fake.listStoriesMutex.Lock()
+	ret, specificReturn := fake.listStoriesReturnsOnCall[len(fake.listStoriesArgsForCall)]
+	fake.listStoriesArgsForCall = append(fake.listStoriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListStories", []interface{}{arg1})
+	fake.listStoriesMutex.Unlock()
+	if fake.ListStoriesStub != nil {
+		return fake.ListStoriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listStoriesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStoryCaller) ListStoriesCallCount() int {
+	fake.listStoriesMutex.RLock()
+	defer fake.listStoriesMutex.RUnlock()
+	return len(fake.listStoriesArgsForCall)
+}
+
+func (fake *FakeStoryCaller) ListStoriesCalls(stub func(int) ([]pt.Story, *http.Response, error)) {
+	fake.listStoriesMutex.Lock()
+	defer fake.listStoriesMutex.Unlock()
+	fake.ListStoriesStub = stub
+}
+
+func (fake *FakeStoryCaller) ListStoriesArgsForCall(i int) int {
+	fake.listStoriesMutex.RLock()
+	defer fake.listStoriesMutex.RUnlock()
+	argsForCall := fake.listStoriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStoryCaller) ListStoriesReturns(result1 []pt.Story, result2 *http.Response, result3 error) {
+	fake.listStoriesMutex.Lock()
+	defer fake.listStoriesMutex.Unlock()
+	fake.ListStoriesStub = nil
+	fake.listStoriesReturns = struct {
+		result1 []pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoryCaller) ListStoriesReturnsOnCall(i int, result1 []pt.Story, result2 *http.Response, result3 error) {
+	fake.listStoriesMutex.Lock()
+	defer fake.listStoriesMutex.Unlock()
+	fake.ListStoriesStub = nil
+	if fake.listStoriesReturnsOnCall == nil {
+		fake.listStoriesReturnsOnCall = make(map[int]struct {
+			result1 []pt.Story
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listStoriesReturnsOnCall[i] = struct {
+		result1 []pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoryCaller) NewStory(arg1 int, arg2 pt.StoryRequest) (*pt.Story, *http.Response, error) {
+	fake.newStoryMutex.Lock()
+	ret, specificReturn := fake.newStoryReturnsOnCall[len(fake.newStoryArgsForCall)]
+	fake.newStoryArgsForCall = append(fake.newStoryArgsForCall, struct {
+		arg1 int
+		arg2 pt.StoryRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewStory", []interface{}{arg1, arg2})
+	fake.newStoryMutex.Unlock()
+	if fake.NewStoryStub != nil {
+		return fake.NewStoryStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newStoryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStoryCaller) NewStoryCallCount() int {
+	fake.newStoryMutex.RLock()
+	defer fake.newStoryMutex.RUnlock()
+	return len(fake.newStoryArgsForCall)
+}
+
+func (fake *FakeStoryCaller) NewStoryCalls(stub func(int, pt.StoryRequest) (*pt.Story, *http.Response, error)) {
+	fake.newStoryMutex.Lock()
+	defer fake.newStoryMutex.Unlock()
+	fake.NewStoryStub = stub
+}
+
+func (fake *FakeStoryCaller) NewStoryArgsForCall(i int) (int, pt.StoryRequest) {
+	fake.newStoryMutex.RLock()
+	defer fake.newStoryMutex.RUnlock()
+	argsForCall := fake.newStoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoryCaller) NewStoryReturns(result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.newStoryMutex.Lock()
+	defer fake.newStoryMutex.Unlock()
+	fake.NewStoryStub = nil
+	fake.newStoryReturns = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoryCaller) NewStoryReturnsOnCall(i int, result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.newStoryMutex.Lock()
+	defer fake.newStoryMutex.Unlock()
+	fake.NewStoryStub = nil
+	if fake.newStoryReturnsOnCall == nil {
+		fake.newStoryReturnsOnCall = make(map[int]struct {
+			result1 *pt.Story
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newStoryReturnsOnCall[i] = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoryCaller) UpdateStory(arg1 int, arg2 int, arg3 pt.StoryRequest) (*pt.Story, *http.Response, error) {
+	fake.updateStoryMutex.Lock()
+	ret, specificReturn := fake.updateStoryReturnsOnCall[len(fake.updateStoryArgsForCall)]
+	fake.updateStoryArgsForCall = append(fake.updateStoryArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.StoryRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateStory", []interface{}{arg1, arg2, arg3})
+	fake.updateStoryMutex.Unlock()
+	if fake.UpdateStoryStub != nil {
+		return fake.UpdateStoryStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateStoryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStoryCaller) UpdateStoryCallCount() int {
+	fake.updateStoryMutex.RLock()
+	defer fake.updateStoryMutex.RUnlock()
+	return len(fake.updateStoryArgsForCall)
+}
+
+func (fake *FakeStoryCaller) UpdateStoryCalls(stub func(int, int, pt.StoryRequest) (*pt.Story, *http.Response, error)) {
+	fake.updateStoryMutex.Lock()
+	defer fake.updateStoryMutex.Unlock()
+	fake.UpdateStoryStub = stub
+}
+
+func (fake *FakeStoryCaller) UpdateStoryArgsForCall(i int) (int, int, pt.StoryRequest) {
+	fake.updateStoryMutex.RLock()
+	defer fake.updateStoryMutex.RUnlock()
+	argsForCall := fake.updateStoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStoryCaller) UpdateStoryReturns(result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.updateStoryMutex.Lock()
+	defer fake.updateStoryMutex.Unlock()
+	fake.UpdateStoryStub = nil
+	fake.updateStoryReturns = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoryCaller) UpdateStoryReturnsOnCall(i int, result1 *pt.Story, result2 *http.Response, result3 error) {
+	fake.updateStoryMutex.Lock()
+	defer fake.updateStoryMutex.Unlock()
+	fake.UpdateStoryStub = nil
+	if fake.updateStoryReturnsOnCall == nil {
+		fake.updateStoryReturnsOnCall = make(map[int]struct {
+			result1 *pt.Story
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateStoryReturnsOnCall[i] = struct {
+		result1 *pt.Story
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoryCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteStoryMutex.RLock()
+	defer fake.deleteStoryMutex.RUnlock()
+	fake.getStoryMutex.RLock()
+	defer fake.getStoryMutex.RUnlock()
+	fake.listStoriesMutex.RLock()
+	defer fake.listStoriesMutex.RUnlock()
+	fake.newStoryMutex.RLock()
+	defer fake.newStoryMutex.RUnlock()
+	fake.updateStoryMutex.RLock()
+	defer fake.updateStoryMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStoryCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.StoryCaller = new(FakeStoryCaller)
@@ -0,0 +1,468 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeTaskCaller struct {
+	DeleteTaskStub        func(int, int, int) (*http.Response, error)
+	deleteTaskMutex       sync.RWMutex
+	deleteTaskArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}
+	deleteTaskReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteTaskReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetTaskStub        func(int, int, int) (*pt.Task, *http.Response, error)
+	getTaskMutex       sync.RWMutex
+	getTaskArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}
+	getTaskReturns struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	getTaskReturnsOnCall map[int]struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	ListTasksStub        func(int, int) ([]pt.Task, *http.Response, error)
+	listTasksMutex       sync.RWMutex
+	listTasksArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	listTasksReturns struct {
+		result1 []pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	listTasksReturnsOnCall map[int]struct {
+		result1 []pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	NewTaskStub        func(int, int, pt.TaskRequest) (*pt.Task, *http.Response, error)
+	newTaskMutex       sync.RWMutex
+	newTaskArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.TaskRequest
+	}
+	newTaskReturns struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	newTaskReturnsOnCall map[int]struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	UpdateTaskStub        func(int, int, int, pt.TaskRequest) (*pt.Task, *http.Response, error)
+	updateTaskMutex       sync.RWMutex
+	updateTaskArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+		arg4 pt.TaskRequest
+	}
+	updateTaskReturns struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	updateTaskReturnsOnCall map[int]struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTaskCaller) DeleteTask(arg1 int, arg2 int, arg3 int) (*http.Response, error) {
+	fake.deleteTaskMutex.Lock()
+	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
+	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteTaskMutex.Unlock()
+	if fake.DeleteTaskStub != nil {
+		return fake.DeleteTaskStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteTaskReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskCaller) DeleteTaskCallCount() int {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	return len(fake.deleteTaskArgsForCall)
+}
+
+func (fake *FakeTaskCaller) DeleteTaskCalls(stub func(int, int, int) (*http.Response, error)) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = stub
+}
+
+func (fake *FakeTaskCaller) DeleteTaskArgsForCall(i int) (int, int, int) {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	argsForCall := fake.deleteTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskCaller) DeleteTaskReturns(result1 *http.Response, result2 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	fake.deleteTaskReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCaller) DeleteTaskReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	if fake.deleteTaskReturnsOnCall == nil {
+		fake.deleteTaskReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteTaskReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCaller) GetTask(arg1 int, arg2 int, arg3 int) (*pt.Task, *http.Response, error) {
+	fake.getTaskMutex.Lock()
+	ret, specificReturn := fake.getTaskReturnsOnCall[len(fake.getTaskArgsForCall)]
+	fake.getTaskArgsForCall = append(fake.getTaskArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetTask", []interface{}{arg1, arg2, arg3})
+	fake.getTaskMutex.Unlock()
+	if fake.GetTaskStub != nil {
+		return fake.GetTaskStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getTaskReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskCaller) GetTaskCallCount() int {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return len(fake.getTaskArgsForCall)
+}
+
+func (fake *FakeTaskCaller) GetTaskCalls(stub func(int, int, int) (*pt.Task, *http.Response, error)) {
+	fake.getTaskMutex.Lock()
+	defer fake.getTaskMutex.Unlock()
+	fake.GetTaskStub = stub
+}
+
+func (fake *FakeTaskCaller) GetTaskArgsForCall(i int) (int, int, int) {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	argsForCall := fake.getTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskCaller) GetTaskReturns(result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.getTaskMutex.Lock()
+	defer fake.getTaskMutex.Unlock()
+	fake.GetTaskStub = nil
+	fake.getTaskReturns = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskCaller) GetTaskReturnsOnCall(i int, result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.getTaskMutex.Lock()
+	defer fake.getTaskMutex.Unlock()
+	fake.GetTaskStub = nil
+	if fake.getTaskReturnsOnCall == nil {
+		fake.getTaskReturnsOnCall = make(map[int]struct {
+			result1 *pt.Task
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getTaskReturnsOnCall[i] = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskCaller) ListTasks(arg1 int, arg2 int) ([]pt.Task, *http.Response, error) {
+	fake.listTasksMutex.Lock()
+	ret, specificReturn := fake.listTasksReturnsOnCall[len(fake.listTasksArgsForCall)]
+	fake.listTasksArgsForCall = append(fake.listTasksArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("ListTasks", []interface{}{arg1, arg2})
+	fake.listTasksMutex.Unlock()
+	if fake.ListTasksStub != nil {
+		return fake.ListTasksStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listTasksReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskCaller) ListTasksCallCount() int {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	return len(fake.listTasksArgsForCall)
+}
+
+func (fake *FakeTaskCaller) ListTasksCalls(stub func(int, int) ([]pt.Task, *http.Response, error)) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = stub
+}
+
+func (fake *FakeTaskCaller) ListTasksArgsForCall(i int) (int, int) {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	argsForCall := fake.listTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskCaller) ListTasksReturns(result1 []pt.Task, result2 *http.Response, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	fake.listTasksReturns = struct {
+		result1 []pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskCaller) ListTasksReturnsOnCall(i int, result1 []pt.Task, result2 *http.Response, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	if fake.listTasksReturnsOnCall == nil {
+		fake.listTasksReturnsOnCall = make(map[int]struct {
+			result1 []pt.Task
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listTasksReturnsOnCall[i] = struct {
+		result1 []pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskCaller) NewTask(arg1 int, arg2 int, arg3 pt.TaskRequest) (*pt.Task, *http.Response, error) {
+	fake.newTaskMutex.Lock()
+	ret, specificReturn := fake.newTaskReturnsOnCall[len(fake.newTaskArgsForCall)]
+	fake.newTaskArgsForCall = append(fake.newTaskArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.TaskRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("NewTask", []interface{}{arg1, arg2, arg3})
+	fake.newTaskMutex.Unlock()
+	if fake.NewTaskStub != nil {
+		return fake.NewTaskStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newTaskReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskCaller) NewTaskCallCount() int {
+	fake.newTaskMutex.RLock()
+	defer fake.newTaskMutex.RUnlock()
+	return len(fake.newTaskArgsForCall)
+}
+
+func (fake *FakeTaskCaller) NewTaskCalls(stub func(int, int, pt.TaskRequest) (*pt.Task, *http.Response, error)) {
+	fake.newTaskMutex.Lock()
+	defer fake.newTaskMutex.Unlock()
+	fake.NewTaskStub = stub
+}
+
+func (fake *FakeTaskCaller) NewTaskArgsForCall(i int) (int, int, pt.TaskRequest) {
+	fake.newTaskMutex.RLock()
+	defer fake.newTaskMutex.RUnlock()
+	argsForCall := fake.newTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskCaller) NewTaskReturns(result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.newTaskMutex.Lock()
+	defer fake.newTaskMutex.Unlock()
+	fake.NewTaskStub = nil
+	fake.newTaskReturns = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskCaller) NewTaskReturnsOnCall(i int, result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.newTaskMutex.Lock()
+	defer fake.newTaskMutex.Unlock()
+	fake.NewTaskStub = nil
+	if fake.newTaskReturnsOnCall == nil {
+		fake.newTaskReturnsOnCall = make(map[int]struct {
+			result1 *pt.Task
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newTaskReturnsOnCall[i] = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskCaller) UpdateTask(arg1 int, arg2 int, arg3 int, arg4 pt.TaskRequest) (*pt.Task, *http.Response, error) {
+	fake.updateTaskMutex.Lock()
+	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
+	fake.updateTaskArgsForCall = append(fake.updateTaskArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+		arg4 pt.TaskRequest
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateTaskMutex.Unlock()
+	if fake.UpdateTaskStub != nil {
+		return fake.UpdateTaskStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateTaskReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskCaller) UpdateTaskCallCount() int {
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	return len(fake.updateTaskArgsForCall)
+}
+
+func (fake *FakeTaskCaller) UpdateTaskCalls(stub func(int, int, int, pt.TaskRequest) (*pt.Task, *http.Response, error)) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = stub
+}
+
+func (fake *FakeTaskCaller) UpdateTaskArgsForCall(i int) (int, int, int, pt.TaskRequest) {
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	argsForCall := fake.updateTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTaskCaller) UpdateTaskReturns(result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = nil
+	fake.updateTaskReturns = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskCaller) UpdateTaskReturnsOnCall(i int, result1 *pt.Task, result2 *http.Response, result3 error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = nil
+	if fake.updateTaskReturnsOnCall == nil {
+		fake.updateTaskReturnsOnCall = make(map[int]struct {
+			result1 *pt.Task
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateTaskReturnsOnCall[i] = struct {
+		result1 *pt.Task
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	fake.newTaskMutex.RLock()
+	defer fake.newTaskMutex.RUnlock()
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTaskCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.TaskCaller = new(FakeTaskCaller)
@@ -0,0 +1,458 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeWebhookCaller struct {
+	DeleteWebhookStub        func(int, int) (*http.Response, error)
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteWebhookReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteWebhookReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetWebhookStub        func(int, int) (*pt.Webhook, *http.Response, error)
+	getWebhookMutex       sync.RWMutex
+	getWebhookArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getWebhookReturns struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	getWebhookReturnsOnCall map[int]struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	ListWebhooksStub        func(int) ([]pt.Webhook, *http.Response, error)
+	listWebhooksMutex       sync.RWMutex
+	listWebhooksArgsForCall []struct {
+		arg1 int
+	}
+	listWebhooksReturns struct {
+		result1 []pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	listWebhooksReturnsOnCall map[int]struct {
+		result1 []pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	NewWebhookStub        func(int, pt.WebhookRequest) (*pt.Webhook, *http.Response, error)
+	newWebhookMutex       sync.RWMutex
+	newWebhookArgsForCall []struct {
+		arg1 int
+		arg2 pt.WebhookRequest
+	}
+	newWebhookReturns struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	newWebhookReturnsOnCall map[int]struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	UpdateWebhookStub        func(int, int, pt.WebhookRequest) (*pt.Webhook, *http.Response, error)
+	updateWebhookMutex       sync.RWMutex
+	updateWebhookArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 pt.WebhookRequest
+	}
+	updateWebhookReturns struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	updateWebhookReturnsOnCall map[int]struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWebhookCaller) DeleteWebhook(arg1 int, arg2 int) (*http.Response, error) {
+	fake.deleteWebhookMutex.Lock()
+	ret, specificReturn := fake.deleteWebhookReturnsOnCall[len(fake.deleteWebhookArgsForCall)]
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteWebhook", []interface{}{arg1, arg2})
+	fake.deleteWebhookMutex.Unlock()
+	if fake.DeleteWebhookStub != nil {
+		return fake.DeleteWebhookStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWebhookCaller) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeWebhookCaller) DeleteWebhookCalls(stub func(int, int) (*http.Response, error)) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = stub
+}
+
+func (fake *FakeWebhookCaller) DeleteWebhookArgsForCall(i int) (int, int) {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	argsForCall := fake.deleteWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWebhookCaller) DeleteWebhookReturns(result1 *http.Response, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookCaller) DeleteWebhookReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	if fake.deleteWebhookReturnsOnCall == nil {
+		fake.deleteWebhookReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteWebhookReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookCaller) GetWebhook(arg1 int, arg2 int) (*pt.Webhook, *http.Response, error) {
+	fake.getWebhookMutex.Lock()
+	ret, specificReturn := fake.getWebhookReturnsOnCall[len(fake.getWebhookArgsForCall)]
+	fake.getWebhookArgsForCall = append(fake.getWebhookArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetWebhook", []interface{}{arg1, arg2})
+	fake.getWebhookMutex.Unlock()
+	if fake.GetWebhookStub != nil {
+		return fake.GetWebhookStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWebhookCaller) GetWebhookCallCount() int {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	return len(fake.getWebhookArgsForCall)
+}
+
+func (fake *FakeWebhookCaller) GetWebhookCalls(stub func(int, int) (*pt.Webhook, *http.Response, error)) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = stub
+}
+
+func (fake *FakeWebhookCaller) GetWebhookArgsForCall(i int) (int, int) {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	argsForCall := fake.getWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWebhookCaller) GetWebhookReturns(result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	fake.getWebhookReturns = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWebhookCaller) GetWebhookReturnsOnCall(i int, result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	if fake.getWebhookReturnsOnCall == nil {
+		fake.getWebhookReturnsOnCall = make(map[int]struct {
+			result1 *pt.Webhook
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getWebhookReturnsOnCall[i] = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWebhookCaller) ListWebhooks(arg1 int) ([]pt.Webhook, *http.Response, error) {
+	fake.listWebhooksMutex.Lock()
+	ret, specificReturn := fake.listWebhooksReturnsOnCall[len(fake.listWebhooksArgsForCall)]
+	fake.listWebhooksArgsForCall = append(fake.listWebhooksArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListWebhooks", []interface{}{arg1})
+	fake.listWebhooksMutex.Unlock()
+	if fake.ListWebhooksStub != nil {
+		return fake.ListWebhooksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listWebhooksReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWebhookCaller) ListWebhooksCallCount() int {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	return len(fake.listWebhooksArgsForCall)
+}
+
+func (fake *FakeWebhookCaller) ListWebhooksCalls(stub func(int) ([]pt.Webhook, *http.Response, error)) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = stub
+}
+
+func (fake *FakeWebhookCaller) ListWebhooksArgsForCall(i int) int {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	argsForCall := fake.listWebhooksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWebhookCaller) ListWebhooksReturns(result1 []pt.Webhook, result2 *http.Response, result3 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	fake.listWebhooksReturns = struct {
+		result1 []pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWebhookCaller) ListWebhooksReturnsOnCall(i int, result1 []pt.Webhook, result2 *http.Response, result3 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	if fake.listWebhooksReturnsOnCall == nil {
+		fake.listWebhooksReturnsOnCall = make(map[int]struct {
+			result1 []pt.Webhook
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listWebhooksReturnsOnCall[i] = struct {
+		result1 []pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWebhookCaller) NewWebhook(arg1 int, arg2 pt.WebhookRequest) (*pt.Webhook, *http.Response, error) {
+	fake.newWebhookMutex.Lock()
+	ret, specificReturn := fake.newWebhookReturnsOnCall[len(fake.newWebhookArgsForCall)]
+	fake.newWebhookArgsForCall = append(fake.newWebhookArgsForCall, struct {
+		arg1 int
+		arg2 pt.WebhookRequest
+	}{arg1, arg2})
+	fake.recordInvocation("NewWebhook", []interface{}{arg1, arg2})
+	fake.newWebhookMutex.Unlock()
+	if fake.NewWebhookStub != nil {
+		return fake.NewWebhookStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWebhookCaller) NewWebhookCallCount() int {
+	fake.newWebhookMutex.RLock()
+	defer fake.newWebhookMutex.RUnlock()
+	return len(fake.newWebhookArgsForCall)
+}
+
+func (fake *FakeWebhookCaller) NewWebhookCalls(stub func(int, pt.WebhookRequest) (*pt.Webhook, *http.Response, error)) {
+	fake.newWebhookMutex.Lock()
+	defer fake.newWebhookMutex.Unlock()
+	fake.NewWebhookStub = stub
+}
+
+func (fake *FakeWebhookCaller) NewWebhookArgsForCall(i int) (int, pt.WebhookRequest) {
+	fake.newWebhookMutex.RLock()
+	defer fake.newWebhookMutex.RUnlock()
+	argsForCall := fake.newWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWebhookCaller) NewWebhookReturns(result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.newWebhookMutex.Lock()
+	defer fake.newWebhookMutex.Unlock()
+	fake.NewWebhookStub = nil
+	fake.newWebhookReturns = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWebhookCaller) NewWebhookReturnsOnCall(i int, result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.newWebhookMutex.Lock()
+	defer fake.newWebhookMutex.Unlock()
+	fake.NewWebhookStub = nil
+	if fake.newWebhookReturnsOnCall == nil {
+		fake.newWebhookReturnsOnCall = make(map[int]struct {
+			result1 *pt.Webhook
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newWebhookReturnsOnCall[i] = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWebhookCaller) UpdateWebhook(arg1 int, arg2 int, arg3 pt.WebhookRequest) (*pt.Webhook, *http.Response, error) {
+	fake.updateWebhookMutex.Lock()
+	ret, specificReturn := fake.updateWebhookReturnsOnCall[len(fake.updateWebhookArgsForCall)]
+	fake.updateWebhookArgsForCall = append(fake.updateWebhookArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 pt.WebhookRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateWebhook", []interface{}{arg1, arg2, arg3})
+	fake.updateWebhookMutex.Unlock()
+	if fake.UpdateWebhookStub != nil {
+		return fake.UpdateWebhookStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWebhookCaller) UpdateWebhookCallCount() int {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	return len(fake.updateWebhookArgsForCall)
+}
+
+func (fake *FakeWebhookCaller) UpdateWebhookCalls(stub func(int, int, pt.WebhookRequest) (*pt.Webhook, *http.Response, error)) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = stub
+}
+
+func (fake *FakeWebhookCaller) UpdateWebhookArgsForCall(i int) (int, int, pt.WebhookRequest) {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	argsForCall := fake.updateWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeWebhookCaller) UpdateWebhookReturns(result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	fake.updateWebhookReturns = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWebhookCaller) UpdateWebhookReturnsOnCall(i int, result1 *pt.Webhook, result2 *http.Response, result3 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	if fake.updateWebhookReturnsOnCall == nil {
+		fake.updateWebhookReturnsOnCall = make(map[int]struct {
+			result1 *pt.Webhook
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateWebhookReturnsOnCall[i] = struct {
+		result1 *pt.Webhook
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWebhookCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	fake.newWebhookMutex.RLock()
+	defer fake.newWebhookMutex.RUnlock()
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWebhookCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.WebhookCaller = new(FakeWebhookCaller)
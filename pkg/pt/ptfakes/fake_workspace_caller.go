@@ -0,0 +1,441 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ptfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+type FakeWorkspaceCaller struct {
+	DeleteWorkspaceStub        func(int) (*http.Response, error)
+	deleteWorkspaceMutex       sync.RWMutex
+	deleteWorkspaceArgsForCall []struct {
+		arg1 int
+	}
+	deleteWorkspaceReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteWorkspaceReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetWorkspaceStub        func(int) (*pt.Workspace, *http.Response, error)
+	getWorkspaceMutex       sync.RWMutex
+	getWorkspaceArgsForCall []struct {
+		arg1 int
+	}
+	getWorkspaceReturns struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	getWorkspaceReturnsOnCall map[int]struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	ListWorkspacesStub        func() ([]pt.Workspace, *http.Response, error)
+	listWorkspacesMutex       sync.RWMutex
+	listWorkspacesArgsForCall []struct {
+	}
+	listWorkspacesReturns struct {
+		result1 []pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	listWorkspacesReturnsOnCall map[int]struct {
+		result1 []pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	NewWorkspaceStub        func(pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error)
+	newWorkspaceMutex       sync.RWMutex
+	newWorkspaceArgsForCall []struct {
+		arg1 pt.WorkspaceRequest
+	}
+	newWorkspaceReturns struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	newWorkspaceReturnsOnCall map[int]struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	UpdateWorkspaceStub        func(int, pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error)
+	updateWorkspaceMutex       sync.RWMutex
+	updateWorkspaceArgsForCall []struct {
+		arg1 int
+		arg2 pt.WorkspaceRequest
+	}
+	updateWorkspaceReturns struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	updateWorkspaceReturnsOnCall map[int]struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWorkspaceCaller) DeleteWorkspace(arg1 int) (*http.Response, error) {
+	fake.deleteWorkspaceMutex.Lock()
+	ret, specificReturn := fake.deleteWorkspaceReturnsOnCall[len(fake.deleteWorkspaceArgsForCall)]
+	fake.deleteWorkspaceArgsForCall = append(fake.deleteWorkspaceArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteWorkspace", []interface{}{arg1})
+	fake.deleteWorkspaceMutex.Unlock()
+	if fake.DeleteWorkspaceStub != nil {
+		return fake.DeleteWorkspaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWorkspaceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkspaceCaller) DeleteWorkspaceCallCount() int {
+	fake.deleteWorkspaceMutex.RLock()
+	defer fake.deleteWorkspaceMutex.RUnlock()
+	return len(fake.deleteWorkspaceArgsForCall)
+}
+
+func (fake *FakeWorkspaceCaller) DeleteWorkspaceCalls(stub func(int) (*http.Response, error)) {
+	fake.deleteWorkspaceMutex.Lock()
+	defer fake.deleteWorkspaceMutex.Unlock()
+	fake.DeleteWorkspaceStub = stub
+}
+
+func (fake *FakeWorkspaceCaller) DeleteWorkspaceArgsForCall(i int) int {
+	fake.deleteWorkspaceMutex.RLock()
+	defer fake.deleteWorkspaceMutex.RUnlock()
+	argsForCall := fake.deleteWorkspaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorkspaceCaller) DeleteWorkspaceReturns(result1 *http.Response, result2 error) {
+	fake.deleteWorkspaceMutex.Lock()
+	defer fake.deleteWorkspaceMutex.Unlock()
+	fake.DeleteWorkspaceStub = nil
+	fake.deleteWorkspaceReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkspaceCaller) DeleteWorkspaceReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteWorkspaceMutex.Lock()
+	defer fake.deleteWorkspaceMutex.Unlock()
+	fake.DeleteWorkspaceStub = nil
+	if fake.deleteWorkspaceReturnsOnCall == nil {
+		fake.deleteWorkspaceReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteWorkspaceReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkspaceCaller) GetWorkspace(arg1 int) (*pt.Workspace, *http.Response, error) {
+	fake.getWorkspaceMutex.Lock()
+	ret, specificReturn := fake.getWorkspaceReturnsOnCall[len(fake.getWorkspaceArgsForCall)]
+	fake.getWorkspaceArgsForCall = append(fake.getWorkspaceArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("GetWorkspace", []interface{}{arg1})
+	fake.getWorkspaceMutex.Unlock()
+	if fake.GetWorkspaceStub != nil {
+		return fake.GetWorkspaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getWorkspaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorkspaceCaller) GetWorkspaceCallCount() int {
+	fake.getWorkspaceMutex.RLock()
+	defer fake.getWorkspaceMutex.RUnlock()
+	return len(fake.getWorkspaceArgsForCall)
+}
+
+func (fake *FakeWorkspaceCaller) GetWorkspaceCalls(stub func(int) (*pt.Workspace, *http.Response, error)) {
+	fake.getWorkspaceMutex.Lock()
+	defer fake.getWorkspaceMutex.Unlock()
+	fake.GetWorkspaceStub = stub
+}
+
+func (fake *FakeWorkspaceCaller) GetWorkspaceArgsForCall(i int) int {
+	fake.getWorkspaceMutex.RLock()
+	defer fake.getWorkspaceMutex.RUnlock()
+	argsForCall := fake.getWorkspaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorkspaceCaller) GetWorkspaceReturns(result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.getWorkspaceMutex.Lock()
+	defer fake.getWorkspaceMutex.Unlock()
+	fake.GetWorkspaceStub = nil
+	fake.getWorkspaceReturns = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorkspaceCaller) GetWorkspaceReturnsOnCall(i int, result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.getWorkspaceMutex.Lock()
+	defer fake.getWorkspaceMutex.Unlock()
+	fake.GetWorkspaceStub = nil
+	if fake.getWorkspaceReturnsOnCall == nil {
+		fake.getWorkspaceReturnsOnCall = make(map[int]struct {
+			result1 *pt.Workspace
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.getWorkspaceReturnsOnCall[i] = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorkspaceCaller) ListWorkspaces() ([]pt.Workspace, *http.Response, error) {
+	fake.listWorkspacesMutex.Lock()
+	ret, specificReturn := fake.listWorkspacesReturnsOnCall[len(fake.listWorkspacesArgsForCall)]
+	fake.listWorkspacesArgsForCall = append(fake.listWorkspacesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListWorkspaces", []interface{}{})
+	fake.listWorkspacesMutex.Unlock()
+	if fake.ListWorkspacesStub != nil {
+		return fake.ListWorkspacesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listWorkspacesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorkspaceCaller) ListWorkspacesCallCount() int {
+	fake.listWorkspacesMutex.RLock()
+	defer fake.listWorkspacesMutex.RUnlock()
+	return len(fake.listWorkspacesArgsForCall)
+}
+
+func (fake *FakeWorkspaceCaller) ListWorkspacesCalls(stub func() ([]pt.Workspace, *http.Response, error)) {
+	fake.listWorkspacesMutex.Lock()
+	defer fake.listWorkspacesMutex.Unlock()
+	fake.ListWorkspacesStub = stub
+}
+
+func (fake *FakeWorkspaceCaller) ListWorkspacesReturns(result1 []pt.Workspace, result2 *http.Response, result3 error) {
+	fake.listWorkspacesMutex.Lock()
+	defer fake.listWorkspacesMutex.Unlock()
+	fake.ListWorkspacesStub = nil
+	fake.listWorkspacesReturns = struct {
+		result1 []pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorkspaceCaller) ListWorkspacesReturnsOnCall(i int, result1 []pt.Workspace, result2 *http.Response, result3 error) {
+	fake.listWorkspacesMutex.Lock()
+	defer fake.listWorkspacesMutex.Unlock()
+	fake.ListWorkspacesStub = nil
+	if fake.listWorkspacesReturnsOnCall == nil {
+		fake.listWorkspacesReturnsOnCall = make(map[int]struct {
+			result1 []pt.Workspace
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.listWorkspacesReturnsOnCall[i] = struct {
+		result1 []pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorkspaceCaller) NewWorkspace(arg1 pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error) {
+	fake.newWorkspaceMutex.Lock()
+	ret, specificReturn := fake.newWorkspaceReturnsOnCall[len(fake.newWorkspaceArgsForCall)]
+	fake.newWorkspaceArgsForCall = append(fake.newWorkspaceArgsForCall, struct {
+		arg1 pt.WorkspaceRequest
+	}{arg1})
+	fake.recordInvocation("NewWorkspace", []interface{}{arg1})
+	fake.newWorkspaceMutex.Unlock()
+	if fake.NewWorkspaceStub != nil {
+		return fake.NewWorkspaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.newWorkspaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorkspaceCaller) NewWorkspaceCallCount() int {
+	fake.newWorkspaceMutex.RLock()
+	defer fake.newWorkspaceMutex.RUnlock()
+	return len(fake.newWorkspaceArgsForCall)
+}
+
+func (fake *FakeWorkspaceCaller) NewWorkspaceCalls(stub func(pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error)) {
+	fake.newWorkspaceMutex.Lock()
+	defer fake.newWorkspaceMutex.Unlock()
+	fake.NewWorkspaceStub = stub
+}
+
+func (fake *FakeWorkspaceCaller) NewWorkspaceArgsForCall(i int) pt.WorkspaceRequest {
+	fake.newWorkspaceMutex.RLock()
+	defer fake.newWorkspaceMutex.RUnlock()
+	argsForCall := fake.newWorkspaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorkspaceCaller) NewWorkspaceReturns(result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.newWorkspaceMutex.Lock()
+	defer fake.newWorkspaceMutex.Unlock()
+	fake.NewWorkspaceStub = nil
+	fake.newWorkspaceReturns = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorkspaceCaller) NewWorkspaceReturnsOnCall(i int, result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.newWorkspaceMutex.Lock()
+	defer fake.newWorkspaceMutex.Unlock()
+	fake.NewWorkspaceStub = nil
+	if fake.newWorkspaceReturnsOnCall == nil {
+		fake.newWorkspaceReturnsOnCall = make(map[int]struct {
+			result1 *pt.Workspace
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.newWorkspaceReturnsOnCall[i] = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorkspaceCaller) UpdateWorkspace(arg1 int, arg2 pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error) {
+	fake.updateWorkspaceMutex.Lock()
+	ret, specificReturn := fake.updateWorkspaceReturnsOnCall[len(fake.updateWorkspaceArgsForCall)]
+	fake.updateWorkspaceArgsForCall = append(fake.updateWorkspaceArgsForCall, struct {
+		arg1 int
+		arg2 pt.WorkspaceRequest
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateWorkspace", []interface{}{arg1, arg2})
+	fake.updateWorkspaceMutex.Unlock()
+	if fake.UpdateWorkspaceStub != nil {
+		return fake.UpdateWorkspaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateWorkspaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorkspaceCaller) UpdateWorkspaceCallCount() int {
+	fake.updateWorkspaceMutex.RLock()
+	defer fake.updateWorkspaceMutex.RUnlock()
+	return len(fake.updateWorkspaceArgsForCall)
+}
+
+func (fake *FakeWorkspaceCaller) UpdateWorkspaceCalls(stub func(int, pt.WorkspaceRequest) (*pt.Workspace, *http.Response, error)) {
+	fake.updateWorkspaceMutex.Lock()
+	defer fake.updateWorkspaceMutex.Unlock()
+	fake.UpdateWorkspaceStub = stub
+}
+
+func (fake *FakeWorkspaceCaller) UpdateWorkspaceArgsForCall(i int) (int, pt.WorkspaceRequest) {
+	fake.updateWorkspaceMutex.RLock()
+	defer fake.updateWorkspaceMutex.RUnlock()
+	argsForCall := fake.updateWorkspaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorkspaceCaller) UpdateWorkspaceReturns(result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.updateWorkspaceMutex.Lock()
+	defer fake.updateWorkspaceMutex.Unlock()
+	fake.UpdateWorkspaceStub = nil
+	fake.updateWorkspaceReturns = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorkspaceCaller) UpdateWorkspaceReturnsOnCall(i int, result1 *pt.Workspace, result2 *http.Response, result3 error) {
+	fake.updateWorkspaceMutex.Lock()
+	defer fake.updateWorkspaceMutex.Unlock()
+	fake.UpdateWorkspaceStub = nil
+	if fake.updateWorkspaceReturnsOnCall == nil {
+		fake.updateWorkspaceReturnsOnCall = make(map[int]struct {
+			result1 *pt.Workspace
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.updateWorkspaceReturnsOnCall[i] = struct {
+		result1 *pt.Workspace
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorkspaceCaller) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteWorkspaceMutex.RLock()
+	defer fake.deleteWorkspaceMutex.RUnlock()
+	fake.getWorkspaceMutex.RLock()
+	defer fake.getWorkspaceMutex.RUnlock()
+	fake.listWorkspacesMutex.RLock()
+	defer fake.listWorkspacesMutex.RUnlock()
+	fake.newWorkspaceMutex.RLock()
+	defer fake.newWorkspaceMutex.RUnlock()
+	fake.updateWorkspaceMutex.RLock()
+	defer fake.updateWorkspaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWorkspaceCaller) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pt.WorkspaceCaller = new(FakeWorkspaceCaller)
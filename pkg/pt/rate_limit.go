@@ -0,0 +1,77 @@
+package pt
+
+import (
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimitedRequestDoer holds every request back until the token bucket
+// it shares with all other callers of the same client allows it through.
+type rateLimitedRequestDoer struct {
+	RequestDoer
+	bucket *tokenBucket
+}
+
+// NewRateLimitedRequestDoer wraps the given RequestDoer so that no more
+// than requestsPerSecond requests are sent on average, allowing bursts of
+// up to one second worth of requests. A requestsPerSecond of zero or less
+// disables the limit.
+func NewRateLimitedRequestDoer(doer RequestDoer, requestsPerSecond float64) RequestDoer {
+	if requestsPerSecond <= 0 {
+		return doer
+	}
+
+	return &rateLimitedRequestDoer{
+		RequestDoer: doer,
+		bucket:      newTokenBucket(requestsPerSecond),
+	}
+}
+
+// Do waits for a token and then sends the request.
+func (doer *rateLimitedRequestDoer) Do(req *http.Request, v interface{}) (*http.Response, error) {
+	timer := time.NewTimer(doer.bucket.reserve())
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	case <-timer.C:
+	}
+
+	return doer.RequestDoer.Do(req, v)
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Ceil(rate))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token out of the bucket and returns how long the caller
+// has to wait before that token becomes available.
+func (bucket *tokenBucket) reserve() time.Duration {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	now := time.Now()
+	bucket.tokens = math.Min(bucket.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*bucket.rate)
+	bucket.last = now
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+}
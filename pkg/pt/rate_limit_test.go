@@ -0,0 +1,64 @@
+package pt_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestRateLimitedRequestDoer(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Do", func(t *testing.T) {
+		t.Run("when requests are sent in parallel", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturns(newResponse(http.StatusOK, nil), nil)
+			doer := pt.NewRateLimitedRequestDoer(fakeDoer, 20)
+			start := time.Now()
+			var wg sync.WaitGroup
+			for i := 0; i < 30; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+					doer.Do(req, nil)
+				}()
+			}
+			wg.Wait()
+			Expect(fakeDoer.DoCallCount()).To(Equal(30),
+				"it should send every request",
+			)
+			Expect(time.Since(start)).To(BeNumerically(">=", 450*time.Millisecond),
+				"it should hold back the requests beyond the burst",
+			)
+		})
+
+		t.Run("when the request is cancelled while waiting", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			doer := pt.NewRateLimitedRequestDoer(fakeDoer, 0.1)
+			req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+			doer.Do(req, nil)
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := doer.Do(req.WithContext(ctx), nil)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(1),
+				"it should not send the cancelled request",
+			)
+		})
+
+		t.Run("when the limit is disabled", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			Expect(pt.NewRateLimitedRequestDoer(fakeDoer, 0)).To(BeIdenticalTo(fakeDoer),
+				"it should return the wrapped doer",
+			)
+		})
+	})
+}
@@ -0,0 +1,99 @@
+package pt
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultBaseURL is the public tracker v5 api endpoint.
+const DefaultBaseURL = "https://www.pivotaltracker.com/services/v5/"
+
+const userAgent = "terraform-provider-pivotaltracker"
+
+// trackerRequestDoer builds and executes requests against the tracker api,
+// decoding error responses into an *Error instead of discarding them.
+type trackerRequestDoer struct {
+	apiToken   string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewRequestDoer returns the RequestDoer used to talk to the tracker api
+// found at baseURL, or at DefaultBaseURL when baseURL is empty.
+func NewRequestDoer(apiToken string, baseURL string) RequestDoer {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+
+	return &trackerRequestDoer{
+		apiToken:   apiToken,
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// NewRequest builds a request for the given path relative to the base url,
+// with body encoded as json.
+func (doer *trackerRequestDoer) NewRequest(method, urlPath string, body interface{}) (*http.Request, error) {
+	base, err := url.Parse(doer.baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := url.Parse(urlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf io.Reader
+	if body != nil {
+		encoded := &bytes.Buffer{}
+		if err := json.NewEncoder(encoded).Encode(body); err != nil {
+			return nil, err
+		}
+		buf = encoded
+	}
+
+	req, err := http.NewRequest(method, base.ResolveReference(path).String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-TrackerToken", doer.apiToken)
+	req.Header.Set("User-Agent", userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// Do sends the request and decodes a successful response body into v.
+func (doer *trackerRequestDoer) Do(req *http.Request, v interface{}) (*http.Response, error) {
+	resp, err := doer.httpClient.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, newError(resp)
+	}
+
+	if v == nil {
+		return resp, nil
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(v)
+	if err == io.EOF {
+		err = nil
+	}
+	return resp, err
+}
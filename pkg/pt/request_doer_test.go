@@ -0,0 +1,184 @@
+package pt_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+func TestRequestDoer(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Do", func(t *testing.T) {
+		t.Run("when the api responds successfully", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"id": 1234, "name": "some project"}`)
+			}))
+			defer server.Close()
+
+			req, _ := http.NewRequest("GET", server.URL, nil)
+			project := &pt.Project{}
+			_, err := pt.NewRequestDoer("token", "").Do(req, project)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(project.ID).To(Equal(1234),
+				"it should decode the response body",
+			)
+		})
+
+		t.Run("when the api responds with no content", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			req, _ := http.NewRequest("DELETE", server.URL, nil)
+			_, err := pt.NewRequestDoer("token", "").Do(req, &pt.Project{})
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+		})
+
+		t.Run("when the api responds with an error", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"code": "unfound_resource", "kind": "error", "error": "The object you tried to access could not be found."}`)
+			}))
+			defer server.Close()
+
+			req, _ := http.NewRequest("GET", server.URL, nil)
+			resp, err := pt.NewRequestDoer("token", "").Do(req, &pt.Project{})
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound),
+				"it should return the response",
+			)
+			apiErr, ok := err.(*pt.Error)
+			Expect(ok).To(BeTrue(),
+				"it should return an *Error",
+			)
+			Expect(apiErr.StatusCode).To(Equal(http.StatusNotFound))
+			Expect(apiErr.Code).To(Equal("unfound_resource"))
+			Expect(apiErr.Kind).To(Equal("error"))
+			Expect(apiErr.Message).To(Equal("The object you tried to access could not be found."))
+		})
+
+		t.Run("when the api responds with validation errors", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{
+					"code": "invalid_parameter",
+					"kind": "error",
+					"error": "One or more request parameters was missing or invalid.",
+					"general_problem": "Name is invalid",
+					"possible_fix": "Pick another name",
+					"validation_errors": [
+						{"field": "name", "problem": "already used by another project in this account"}
+					]
+				}`)
+			}))
+			defer server.Close()
+
+			req, _ := http.NewRequest("POST", server.URL, nil)
+			_, err := pt.NewRequestDoer("token", "").Do(req, &pt.Project{})
+			var apiErr *pt.Error
+			Expect(errors.As(fmt.Errorf("failed calling service: %w", err), &apiErr)).To(BeTrue(),
+				"it should be reachable with errors.As",
+			)
+			Expect(apiErr.GeneralProblem).To(Equal("Name is invalid"))
+			Expect(apiErr.PossibleFix).To(Equal("Pick another name"))
+			Expect(apiErr.ValidationErrors).To(Equal([]pt.ValidationError{
+				{Field: "name", Problem: "already used by another project in this account"},
+			}))
+			Expect(err.Error()).To(ContainSubstring("name: already used by another project in this account"),
+				"it should surface the field level problems",
+			)
+			Expect(err.Error()).To(ContainSubstring("Pick another name"),
+				"it should surface the possible fix",
+			)
+		})
+
+		t.Run("when the error body is not json", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+				fmt.Fprint(w, `<html>bad gateway</html>`)
+			}))
+			defer server.Close()
+
+			req, _ := http.NewRequest("GET", server.URL, nil)
+			_, err := pt.NewRequestDoer("token", "").Do(req, &pt.Project{})
+			apiErr, ok := err.(*pt.Error)
+			Expect(ok).To(BeTrue(),
+				"it should return an *Error",
+			)
+			Expect(apiErr.StatusCode).To(Equal(http.StatusBadGateway))
+			Expect(apiErr.Message).To(Equal(http.StatusText(http.StatusBadGateway)))
+		})
+	})
+
+	t.Run("NewRequest", func(t *testing.T) {
+		t.Run("when no base url is given", func(t *testing.T) {
+			req, err := pt.NewRequestDoer("token", "").NewRequest("GET", "projects/1234", nil)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(req.URL.String()).To(Equal(pt.DefaultBaseURL+"projects/1234"),
+				"it should target the public tracker api",
+			)
+			Expect(req.Header.Get("X-TrackerToken")).To(Equal("token"),
+				"it should authenticate with the api token",
+			)
+		})
+
+		t.Run("when a base url is given", func(t *testing.T) {
+			var controlPath, controlToken, controlBody string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				controlPath, controlToken, controlBody = r.URL.Path, r.Header.Get("X-TrackerToken"), string(body)
+				fmt.Fprint(w, `{"id": 1234}`)
+			}))
+			defer server.Close()
+
+			doer := pt.NewRequestDoer("token", server.URL+"/services/v5")
+			req, err := doer.NewRequest("PUT", "projects/1234", pt.ProjectRequest{Name: "some project"})
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(req.Header.Get("Content-Type")).To(Equal("application/json"))
+			_, err = doer.Do(req, &pt.Project{})
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(controlPath).To(Equal("/services/v5/projects/1234"),
+				"it should resolve the path against the base url",
+			)
+			Expect(controlToken).To(Equal("token"))
+			Expect(controlBody).To(MatchJSON(`{"name": "some project"}`))
+		})
+
+		t.Run("when the base url is invalid", func(t *testing.T) {
+			_, err := pt.NewRequestDoer("token", "http://[::1").NewRequest("GET", "projects", nil)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+	})
+
+	t.Run("IsNotFound", func(t *testing.T) {
+		notFound := &pt.Error{StatusCode: http.StatusNotFound}
+		Expect(pt.IsNotFound(notFound)).To(BeTrue())
+		Expect(pt.IsNotFound(fmt.Errorf("failed calling service: %w", notFound))).To(BeTrue(),
+			"it should see through wrapped errors",
+		)
+		Expect(pt.IsNotFound(&pt.Error{StatusCode: http.StatusForbidden})).To(BeFalse())
+		Expect(pt.IsNotFound(fmt.Errorf("some erroor msg"))).To(BeFalse())
+		Expect(pt.IsNotFound(nil)).To(BeFalse())
+	})
+}
@@ -0,0 +1,125 @@
+package pt
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const retryBaseWait = 500 * time.Millisecond
+
+// retryingRequestDoer retries requests the tracker api answered with a 429
+// or a 5xx, waiting between attempts with exponential backoff and jitter,
+// or for as long as the Retry-After header asks. A POST is only retried on a
+// 429, as a 5xx does not tell whether tracker created the object already.
+type retryingRequestDoer struct {
+	RequestDoer
+	maxRetries int
+	maxWait    time.Duration
+}
+
+// NewRetryingRequestDoer wraps the given RequestDoer so that rate limited
+// and failed requests are retried up to maxRetries times, never waiting
+// longer than maxWait between two attempts. A Retry-After longer than
+// maxWait is not waited out and the last response is returned instead.
+func NewRetryingRequestDoer(doer RequestDoer, maxRetries int, maxWait time.Duration) RequestDoer {
+	return &retryingRequestDoer{
+		RequestDoer: doer,
+		maxRetries:  maxRetries,
+		maxWait:     maxWait,
+	}
+}
+
+// Do sends the request, retrying it while the response is retryable.
+func (doer *retryingRequestDoer) Do(req *http.Request, v interface{}) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := doer.RequestDoer.Do(req, v)
+		if err == nil || attempt >= doer.maxRetries || !isRetryable(req, resp) {
+			return resp, err
+		}
+
+		wait, ok := doer.backoff(attempt, resp)
+		if !ok || !rewindBody(req) {
+			return resp, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return resp, err
+		case <-timer.C:
+		}
+	}
+}
+
+func (doer *retryingRequestDoer) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if wait, ok := retryAfter(resp); ok {
+		return wait, wait <= doer.maxWait
+	}
+
+	wait := doer.maxWait
+	if attempt < 30 && retryBaseWait<<uint(attempt) < wait {
+		wait = retryBaseWait << uint(attempt)
+	}
+
+	if wait <= 0 {
+		return 0, true
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1)), true
+}
+
+func isRetryable(req *http.Request, resp *http.Response) bool {
+	if resp == nil {
+		return false
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if req.Method == http.MethodPost || resp.StatusCode == http.StatusNotImplemented {
+		return false
+	}
+	return resp.StatusCode >= 500
+}
+
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// rewindBody resets the request body so the request can be sent again. It
+// reports false when the body can not be replayed.
+func rewindBody(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+
+	if req.GetBody == nil {
+		return false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = body
+	return true
+}
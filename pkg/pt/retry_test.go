@@ -0,0 +1,197 @@
+package pt_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestRetryingRequestDoer(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Do", func(t *testing.T) {
+		t.Run("when the request succeeds", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturns(newResponse(http.StatusOK, nil), nil)
+			req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+			_, err := pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).Do(req, nil)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(1),
+				"it should not retry",
+			)
+		})
+
+		for _, statusCode := range []int{http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway} {
+			t.Run(fmt.Sprintf("when tracker responds %v and then recovers", statusCode), func(t *testing.T) {
+				fakeDoer := &ptfakes.FakeRequestDoer{}
+				fakeDoer.DoReturnsOnCall(0, newResponse(statusCode, nil), &pt.Error{StatusCode: statusCode})
+				fakeDoer.DoReturnsOnCall(1, newResponse(http.StatusOK, nil), nil)
+				req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+				_, err := pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).Do(req, nil)
+				Expect(err).NotTo(HaveOccurred(),
+					"it should not error",
+				)
+				Expect(fakeDoer.DoCallCount()).To(Equal(2),
+					"it should retry the request",
+				)
+			})
+		}
+
+		t.Run("when tracker keeps failing", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturns(newResponse(http.StatusServiceUnavailable, nil), &pt.Error{StatusCode: http.StatusServiceUnavailable})
+			req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+			resp, err := pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).Do(req, nil)
+			Expect(err).To(HaveOccurred(),
+				"it should return the last error",
+			)
+			Expect(resp.StatusCode).To(Equal(http.StatusServiceUnavailable),
+				"it should return the last response",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(4),
+				"it should give up after max retries",
+			)
+		})
+
+		t.Run("when tracker responds with a client error", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturns(newResponse(http.StatusBadRequest, nil), &pt.Error{StatusCode: http.StatusBadRequest})
+			req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+			_, err := pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).Do(req, nil)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(1),
+				"it should not retry",
+			)
+		})
+
+		t.Run("when tracker asks to retry after a delay", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturnsOnCall(0, newResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}}), &pt.Error{StatusCode: http.StatusTooManyRequests})
+			fakeDoer.DoReturnsOnCall(1, newResponse(http.StatusOK, nil), nil)
+			req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+			start := time.Now()
+			_, err := pt.NewRetryingRequestDoer(fakeDoer, 3, 5*time.Second).Do(req, nil)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second),
+				"it should wait for as long as Retry-After asks",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(2),
+				"it should retry the request",
+			)
+		})
+
+		t.Run("when tracker asks to retry after more than the max wait", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturns(newResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"3600"}}), &pt.Error{StatusCode: http.StatusTooManyRequests})
+			req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+			_, err := pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).Do(req, nil)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(1),
+				"it should not wait out the delay",
+			)
+		})
+
+		t.Run("when tracker responds 501", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturns(newResponse(http.StatusNotImplemented, nil), &pt.Error{StatusCode: http.StatusNotImplemented})
+			req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+			_, err := pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).Do(req, nil)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(1),
+				"it should not retry",
+			)
+		})
+
+		t.Run("when a POST gets a 502", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturns(newResponse(http.StatusBadGateway, nil), &pt.Error{StatusCode: http.StatusBadGateway})
+			req, _ := http.NewRequest("POST", "https://example.com/projects", bytes.NewBufferString(`{"name":"some project"}`))
+			_, err := pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).Do(req, nil)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(1),
+				"it should send the request exactly once, tracker may have created the object",
+			)
+		})
+
+		t.Run("when a POST is rate limited", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturnsOnCall(0, newResponse(http.StatusTooManyRequests, nil), &pt.Error{StatusCode: http.StatusTooManyRequests})
+			fakeDoer.DoReturnsOnCall(1, newResponse(http.StatusOK, nil), nil)
+			req, _ := http.NewRequest("POST", "https://example.com/projects", bytes.NewBufferString(`{"name":"some project"}`))
+			_, err := pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).Do(req, nil)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(2),
+				"it should retry the request",
+			)
+		})
+
+		t.Run("when the request has a body", func(t *testing.T) {
+			var bodies []string
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoStub = func(req *http.Request, v interface{}) (*http.Response, error) {
+				body, _ := ioutil.ReadAll(req.Body)
+				bodies = append(bodies, string(body))
+				if len(bodies) == 1 {
+					return newResponse(http.StatusBadGateway, nil), &pt.Error{StatusCode: http.StatusBadGateway}
+				}
+				return newResponse(http.StatusOK, nil), nil
+			}
+			req, _ := http.NewRequest("PUT", "https://example.com/projects/1234", bytes.NewBufferString(`{"name":"some project"}`))
+			_, err := pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).Do(req, nil)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(bodies).To(Equal([]string{`{"name":"some project"}`, `{"name":"some project"}`}),
+				"it should send the same body on every attempt",
+			)
+		})
+
+		t.Run("when retries are disabled", func(t *testing.T) {
+			fakeDoer := &ptfakes.FakeRequestDoer{}
+			fakeDoer.DoReturns(newResponse(http.StatusBadGateway, nil), &pt.Error{StatusCode: http.StatusBadGateway})
+			req, _ := http.NewRequest("GET", "https://example.com/projects", nil)
+			_, err := pt.NewRetryingRequestDoer(fakeDoer, 0, time.Millisecond).Do(req, nil)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeDoer.DoCallCount()).To(Equal(1),
+				"it should not retry",
+			)
+		})
+	})
+
+	t.Run("NewRequest", func(t *testing.T) {
+		fakeDoer := &ptfakes.FakeRequestDoer{}
+		pt.NewRetryingRequestDoer(fakeDoer, 3, time.Millisecond).NewRequest("GET", "projects", nil)
+		Expect(fakeDoer.NewRequestCallCount()).To(Equal(1),
+			"it should build requests with the wrapped doer",
+		)
+	})
+}
+
+func newResponse(statusCode int, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{StatusCode: statusCode, Header: header}
+}
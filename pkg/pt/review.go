@@ -0,0 +1,127 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	ReviewStatusUnstarted string = "unstarted"
+	ReviewStatusInReview  string = "in_review"
+	ReviewStatusPass      string = "pass"
+	ReviewStatusRevise    string = "revise"
+)
+
+type Review struct {
+	ReviewRequest
+	Kind    string `json:"kind,omitempty"`
+	ID      int    `json:"id,omitempty"`
+	StoryID int    `json:"story_id,omitempty"`
+}
+
+// ReviewRequest sends a null reviewer_id when ClearReviewerID is set, which
+// unassigns the review.
+type ReviewRequest struct {
+	ReviewTypeID    int    `json:"review_type_id,omitempty"`
+	ReviewerID      int    `json:"reviewer_id,omitempty"`
+	Status          string `json:"status,omitempty"`
+	ClearReviewerID bool   `json:"-"`
+}
+
+// MarshalJSON sends the reviewer_id as null when it is cleared.
+func (review ReviewRequest) MarshalJSON() ([]byte, error) {
+	type reviewRequest ReviewRequest
+	cleared := map[string]interface{}{}
+	if review.ClearReviewerID {
+		cleared["reviewer_id"] = nil
+	}
+	return marshalClearing(reviewRequest(review), cleared)
+}
+
+//go:generate counterfeiter . ReviewCaller
+type ReviewCaller interface {
+	ListReviews(projectID int, storyID int) ([]Review, *http.Response, error)
+	GetReview(projectID int, storyID int, reviewID int) (*Review, *http.Response, error)
+	NewReview(projectID int, storyID int, review ReviewRequest) (*Review, *http.Response, error)
+	UpdateReview(projectID int, storyID int, reviewID int, review ReviewRequest) (*Review, *http.Response, error)
+	DeleteReview(projectID int, storyID int, reviewID int) (*http.Response, error)
+}
+
+// ListReviews - list all reviews of a story
+func (service *Client) ListReviews(projectID int, storyID int) ([]Review, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/stories/%v/reviews", projectID, storyID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseReviews := make([]Review, 0)
+	resp, err := service.Do(req, &responseReviews)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseReviews, resp, nil
+}
+
+// GetReview - retrieve a review's details from the api
+func (service *Client) GetReview(projectID int, storyID int, reviewID int) (*Review, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/stories/%v/reviews/%v", projectID, storyID, reviewID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseReview := &Review{}
+	resp, err := service.Do(req, responseReview)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseReview, resp, nil
+}
+
+// NewReview - adds a review to the given story
+func (service *Client) NewReview(projectID int, storyID int, review ReviewRequest) (*Review, *http.Response, error) {
+	req, err := service.NewRequest("POST", fmt.Sprintf("projects/%v/stories/%v/reviews", projectID, storyID), review)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseReview := &Review{}
+	resp, err := service.Do(req, responseReview)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseReview, resp, nil
+}
+
+// UpdateReview - updates the reviewer or status of a given review.
+func (service *Client) UpdateReview(projectID int, storyID int, reviewID int, review ReviewRequest) (*Review, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/stories/%v/reviews/%v", projectID, storyID, reviewID), review)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseReview := &Review{}
+	resp, err := service.Do(req, responseReview)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseReview, resp, nil
+}
+
+// DeleteReview removes a review from a story by review id.
+func (service *Client) DeleteReview(projectID int, storyID int, reviewID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("projects/%v/stories/%v/reviews/%v", projectID, storyID, reviewID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
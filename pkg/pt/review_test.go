@@ -0,0 +1,78 @@
+package pt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestReviewClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Requests", func(t *testing.T) {
+		t.Run("should send a null reviewer_id when it is cleared", func(t *testing.T) {
+			body, err := json.Marshal(pt.ReviewRequest{ClearReviewerID: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal(`{"reviewer_id":null}`))
+		})
+	})
+
+	t.Run("ReviewCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlProjectID := 1234
+			controlStoryID := 5678
+			controlReviewID := 91011
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteReview", fmt.Sprintf("projects/%v/stories/%v/reviews/%v", controlProjectID, controlStoryID, controlReviewID), "DELETE", false, func() {
+					client.DeleteReview(controlProjectID, controlStoryID, controlReviewID)
+				}},
+				{"UpdateReview", fmt.Sprintf("projects/%v/stories/%v/reviews/%v", controlProjectID, controlStoryID, controlReviewID), "PUT", true, func() {
+					client.UpdateReview(controlProjectID, controlStoryID, controlReviewID, pt.ReviewRequest{})
+				}},
+				{"NewReview", fmt.Sprintf("projects/%v/stories/%v/reviews", controlProjectID, controlStoryID), "POST", true, func() {
+					client.NewReview(controlProjectID, controlStoryID, pt.ReviewRequest{})
+				}},
+				{"ListReviews", fmt.Sprintf("projects/%v/stories/%v/reviews", controlProjectID, controlStoryID), "GET", false, func() {
+					client.ListReviews(controlProjectID, controlStoryID)
+				}},
+				{"GetReview", fmt.Sprintf("projects/%v/stories/%v/reviews/%v", controlProjectID, controlStoryID, controlReviewID), "GET", false, func() {
+					client.GetReview(controlProjectID, controlStoryID, controlReviewID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
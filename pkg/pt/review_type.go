@@ -0,0 +1,106 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type ReviewType struct {
+	ReviewTypeRequest
+	Kind      string `json:"kind,omitempty"`
+	ID        int    `json:"id,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+}
+
+type ReviewTypeRequest struct {
+	Name   string `json:"name,omitempty"`
+	Hidden *bool  `json:"hidden,omitempty"`
+}
+
+//go:generate counterfeiter . ReviewTypeCaller
+type ReviewTypeCaller interface {
+	ListReviewTypes(projectID int) ([]ReviewType, *http.Response, error)
+	GetReviewType(projectID int, reviewTypeID int) (*ReviewType, *http.Response, error)
+	NewReviewType(projectID int, reviewType ReviewTypeRequest) (*ReviewType, *http.Response, error)
+	UpdateReviewType(projectID int, reviewTypeID int, reviewType ReviewTypeRequest) (*ReviewType, *http.Response, error)
+	DeleteReviewType(projectID int, reviewTypeID int) (*http.Response, error)
+}
+
+// ListReviewTypes - list all review types of a project
+func (service *Client) ListReviewTypes(projectID int) ([]ReviewType, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/review_types", projectID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseReviewTypes := make([]ReviewType, 0)
+	resp, err := service.Do(req, &responseReviewTypes)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseReviewTypes, resp, nil
+}
+
+// GetReviewType - retrieve a review type's details from the api
+func (service *Client) GetReviewType(projectID int, reviewTypeID int) (*ReviewType, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/review_types/%v", projectID, reviewTypeID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseReviewType := &ReviewType{}
+	resp, err := service.Do(req, responseReviewType)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseReviewType, resp, nil
+}
+
+// NewReviewType - adds a review type to the given project
+func (service *Client) NewReviewType(projectID int, reviewType ReviewTypeRequest) (*ReviewType, *http.Response, error) {
+	req, err := service.NewRequest("POST", fmt.Sprintf("projects/%v/review_types", projectID), reviewType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseReviewType := &ReviewType{}
+	resp, err := service.Do(req, responseReviewType)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseReviewType, resp, nil
+}
+
+// UpdateReviewType - renames or hides a given review type.
+func (service *Client) UpdateReviewType(projectID int, reviewTypeID int, reviewType ReviewTypeRequest) (*ReviewType, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/review_types/%v", projectID, reviewTypeID), reviewType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseReviewType := &ReviewType{}
+	resp, err := service.Do(req, responseReviewType)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseReviewType, resp, nil
+}
+
+// DeleteReviewType removes a review type from a project by review type id.
+func (service *Client) DeleteReviewType(projectID int, reviewTypeID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("projects/%v/review_types/%v", projectID, reviewTypeID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,68 @@
+package pt_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestReviewTypeClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("ReviewTypeCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlProjectID := 1234
+			controlReviewTypeID := 5678
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteReviewType", fmt.Sprintf("projects/%v/review_types/%v", controlProjectID, controlReviewTypeID), "DELETE", false, func() {
+					client.DeleteReviewType(controlProjectID, controlReviewTypeID)
+				}},
+				{"UpdateReviewType", fmt.Sprintf("projects/%v/review_types/%v", controlProjectID, controlReviewTypeID), "PUT", true, func() {
+					client.UpdateReviewType(controlProjectID, controlReviewTypeID, pt.ReviewTypeRequest{})
+				}},
+				{"NewReviewType", fmt.Sprintf("projects/%v/review_types", controlProjectID), "POST", true, func() {
+					client.NewReviewType(controlProjectID, pt.ReviewTypeRequest{})
+				}},
+				{"ListReviewTypes", fmt.Sprintf("projects/%v/review_types", controlProjectID), "GET", false, func() {
+					client.ListReviewTypes(controlProjectID)
+				}},
+				{"GetReviewType", fmt.Sprintf("projects/%v/review_types/%v", controlProjectID, controlReviewTypeID), "GET", false, func() {
+					client.GetReviewType(controlProjectID, controlReviewTypeID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,156 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	StoryTypeFeature string = "feature"
+	StoryTypeBug     string = "bug"
+	StoryTypeChore   string = "chore"
+	StoryTypeRelease string = "release"
+)
+
+type Story struct {
+	Kind          string     `json:"kind,omitempty"`
+	ID            int        `json:"id,omitempty"`
+	ProjectID     int        `json:"project_id,omitempty"`
+	Name          string     `json:"name,omitempty"`
+	Description   string     `json:"description,omitempty"`
+	StoryType     string     `json:"story_type,omitempty"`
+	CurrentState  string     `json:"current_state,omitempty"`
+	Estimate      *float64   `json:"estimate,omitempty"`
+	Labels        []Label    `json:"labels,omitempty"`
+	OwnerIDs      []int      `json:"owner_ids,omitempty"`
+	RequestedByID int        `json:"requested_by_id,omitempty"`
+	Deadline      *time.Time `json:"deadline,omitempty"`
+	URL           string     `json:"url,omitempty"`
+}
+
+// StoryRequest uses pointers for the estimate and the lists, so that a
+// zero point estimate or an emptied list is still sent to the api. Setting
+// ClearEstimate sends a null estimate, which unestimates the story, and
+// ClearDescription and ClearDeadline empty those.
+type StoryRequest struct {
+	Name             string          `json:"name,omitempty"`
+	Description      string          `json:"description,omitempty"`
+	StoryType        string          `json:"story_type,omitempty"`
+	CurrentState     string          `json:"current_state,omitempty"`
+	Estimate         *float64        `json:"estimate,omitempty"`
+	Labels           *[]LabelRequest `json:"labels,omitempty"`
+	OwnerIDs         *[]int          `json:"owner_ids,omitempty"`
+	RequestedByID    int             `json:"requested_by_id,omitempty"`
+	Deadline         *time.Time      `json:"deadline,omitempty"`
+	ClearEstimate    bool            `json:"-"`
+	ClearDescription bool            `json:"-"`
+	ClearDeadline    bool            `json:"-"`
+}
+
+// MarshalJSON sends the cleared attributes, which omitempty would otherwise
+// leave out.
+func (story StoryRequest) MarshalJSON() ([]byte, error) {
+	type storyRequest StoryRequest
+	cleared := map[string]interface{}{}
+	if story.ClearEstimate {
+		cleared["estimate"] = nil
+	}
+
+	if story.ClearDescription {
+		cleared["description"] = ""
+	}
+
+	if story.ClearDeadline {
+		cleared["deadline"] = nil
+	}
+	return marshalClearing(storyRequest(story), cleared)
+}
+
+//go:generate counterfeiter . StoryCaller
+type StoryCaller interface {
+	ListStories(projectID int) ([]Story, *http.Response, error)
+	GetStory(projectID int, storyID int) (*Story, *http.Response, error)
+	NewStory(projectID int, story StoryRequest) (*Story, *http.Response, error)
+	UpdateStory(projectID int, storyID int, story StoryRequest) (*Story, *http.Response, error)
+	DeleteStory(projectID int, storyID int) (*http.Response, error)
+}
+
+// ListStories - list all stories of a project
+func (service *Client) ListStories(projectID int) ([]Story, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/stories", projectID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseStories := make([]Story, 0)
+	resp, err := service.Do(req, &responseStories)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseStories, resp, nil
+}
+
+// GetStory - retrieve a story's details from the api
+func (service *Client) GetStory(projectID int, storyID int) (*Story, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/stories/%v", projectID, storyID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseStory := &Story{}
+	resp, err := service.Do(req, responseStory)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseStory, resp, nil
+}
+
+// NewStory - creates a story in the given project
+func (service *Client) NewStory(projectID int, story StoryRequest) (*Story, *http.Response, error) {
+	req, err := service.NewRequest("POST", fmt.Sprintf("projects/%v/stories", projectID), story)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseStory := &Story{}
+	resp, err := service.Do(req, responseStory)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseStory, resp, nil
+}
+
+// UpdateStory - updates the given story.
+func (service *Client) UpdateStory(projectID int, storyID int, story StoryRequest) (*Story, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/stories/%v", projectID, storyID), story)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseStory := &Story{}
+	resp, err := service.Do(req, responseStory)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseStory, resp, nil
+}
+
+// DeleteStory removes a story from a project by story id.
+func (service *Client) DeleteStory(projectID int, storyID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("projects/%v/stories/%v", projectID, storyID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
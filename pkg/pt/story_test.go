@@ -0,0 +1,92 @@
+package pt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestStoryClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Requests", func(t *testing.T) {
+		t.Run("should send zero estimates and emptied lists and leave out unset ones", func(t *testing.T) {
+			estimate := 0.0
+			body, err := json.Marshal(pt.StoryRequest{Estimate: &estimate, Labels: &[]pt.LabelRequest{}, OwnerIDs: &[]int{}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal(`{"estimate":0,"labels":[],"owner_ids":[]}`))
+
+			body, err = json.Marshal(pt.StoryRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal(`{}`))
+		})
+
+		t.Run("should send the cleared attributes", func(t *testing.T) {
+			body, err := json.Marshal(pt.StoryRequest{Name: "some story", ClearEstimate: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(MatchJSON(`{"name":"some story","estimate":null}`))
+
+			body, err = json.Marshal(pt.StoryRequest{Name: "some story", ClearDescription: true, ClearDeadline: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(MatchJSON(`{"name":"some story","description":"","deadline":null}`))
+		})
+	})
+
+	t.Run("StoryCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlProjectID := 1234
+			controlStoryID := 5678
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteStory", fmt.Sprintf("projects/%v/stories/%v", controlProjectID, controlStoryID), "DELETE", false, func() {
+					client.DeleteStory(controlProjectID, controlStoryID)
+				}},
+				{"UpdateStory", fmt.Sprintf("projects/%v/stories/%v", controlProjectID, controlStoryID), "PUT", true, func() {
+					client.UpdateStory(controlProjectID, controlStoryID, pt.StoryRequest{})
+				}},
+				{"NewStory", fmt.Sprintf("projects/%v/stories", controlProjectID), "POST", true, func() {
+					client.NewStory(controlProjectID, pt.StoryRequest{})
+				}},
+				{"ListStories", fmt.Sprintf("projects/%v/stories", controlProjectID), "GET", false, func() {
+					client.ListStories(controlProjectID)
+				}},
+				{"GetStory", fmt.Sprintf("projects/%v/stories/%v", controlProjectID, controlStoryID), "GET", false, func() {
+					client.GetStory(controlProjectID, controlStoryID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
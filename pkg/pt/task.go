@@ -0,0 +1,108 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Task struct {
+	TaskRequest
+	Kind      string `json:"kind,omitempty"`
+	ID        int    `json:"id,omitempty"`
+	StoryID   int    `json:"story_id,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+}
+
+type TaskRequest struct {
+	Description string `json:"description,omitempty"`
+	Complete    *bool  `json:"complete,omitempty"`
+	Position    int    `json:"position,omitempty"`
+}
+
+//go:generate counterfeiter . TaskCaller
+type TaskCaller interface {
+	ListTasks(projectID int, storyID int) ([]Task, *http.Response, error)
+	GetTask(projectID int, storyID int, taskID int) (*Task, *http.Response, error)
+	NewTask(projectID int, storyID int, task TaskRequest) (*Task, *http.Response, error)
+	UpdateTask(projectID int, storyID int, taskID int, task TaskRequest) (*Task, *http.Response, error)
+	DeleteTask(projectID int, storyID int, taskID int) (*http.Response, error)
+}
+
+// ListTasks - list all tasks of a story
+func (service *Client) ListTasks(projectID int, storyID int) ([]Task, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/stories/%v/tasks", projectID, storyID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseTasks := make([]Task, 0)
+	resp, err := service.Do(req, &responseTasks)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseTasks, resp, nil
+}
+
+// GetTask - retrieve a task's details from the api
+func (service *Client) GetTask(projectID int, storyID int, taskID int) (*Task, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/stories/%v/tasks/%v", projectID, storyID, taskID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseTask := &Task{}
+	resp, err := service.Do(req, responseTask)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseTask, resp, nil
+}
+
+// NewTask - adds a task to the given story
+func (service *Client) NewTask(projectID int, storyID int, task TaskRequest) (*Task, *http.Response, error) {
+	req, err := service.NewRequest("POST", fmt.Sprintf("projects/%v/stories/%v/tasks", projectID, storyID), task)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseTask := &Task{}
+	resp, err := service.Do(req, responseTask)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseTask, resp, nil
+}
+
+// UpdateTask - updates the description, completion or position of a given task.
+func (service *Client) UpdateTask(projectID int, storyID int, taskID int, task TaskRequest) (*Task, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/stories/%v/tasks/%v", projectID, storyID, taskID), task)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseTask := &Task{}
+	resp, err := service.Do(req, responseTask)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseTask, resp, nil
+}
+
+// DeleteTask removes a task from a story by task id.
+func (service *Client) DeleteTask(projectID int, storyID int, taskID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("projects/%v/stories/%v/tasks/%v", projectID, storyID, taskID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
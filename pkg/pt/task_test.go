@@ -0,0 +1,69 @@
+package pt_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestTaskClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("TaskCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlProjectID := 1234
+			controlStoryID := 5678
+			controlTaskID := 91011
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteTask", fmt.Sprintf("projects/%v/stories/%v/tasks/%v", controlProjectID, controlStoryID, controlTaskID), "DELETE", false, func() {
+					client.DeleteTask(controlProjectID, controlStoryID, controlTaskID)
+				}},
+				{"UpdateTask", fmt.Sprintf("projects/%v/stories/%v/tasks/%v", controlProjectID, controlStoryID, controlTaskID), "PUT", true, func() {
+					client.UpdateTask(controlProjectID, controlStoryID, controlTaskID, pt.TaskRequest{})
+				}},
+				{"NewTask", fmt.Sprintf("projects/%v/stories/%v/tasks", controlProjectID, controlStoryID), "POST", true, func() {
+					client.NewTask(controlProjectID, controlStoryID, pt.TaskRequest{})
+				}},
+				{"ListTasks", fmt.Sprintf("projects/%v/stories/%v/tasks", controlProjectID, controlStoryID), "GET", false, func() {
+					client.ListTasks(controlProjectID, controlStoryID)
+				}},
+				{"GetTask", fmt.Sprintf("projects/%v/stories/%v/tasks/%v", controlProjectID, controlStoryID, controlTaskID), "GET", false, func() {
+					client.GetTask(controlProjectID, controlStoryID, controlTaskID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,106 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Webhook struct {
+	WebhookRequest
+	Kind      string `json:"kind,omitempty"`
+	ID        int    `json:"id,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+}
+
+type WebhookRequest struct {
+	WebhookURL     string `json:"webhook_url,omitempty"`
+	WebhookVersion string `json:"webhook_version,omitempty"`
+}
+
+//go:generate counterfeiter . WebhookCaller
+type WebhookCaller interface {
+	ListWebhooks(projectID int) ([]Webhook, *http.Response, error)
+	GetWebhook(projectID int, webhookID int) (*Webhook, *http.Response, error)
+	NewWebhook(projectID int, webhook WebhookRequest) (*Webhook, *http.Response, error)
+	UpdateWebhook(projectID int, webhookID int, webhook WebhookRequest) (*Webhook, *http.Response, error)
+	DeleteWebhook(projectID int, webhookID int) (*http.Response, error)
+}
+
+// ListWebhooks - list all webhooks of a project
+func (service *Client) ListWebhooks(projectID int) ([]Webhook, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/webhooks", projectID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseWebhooks := make([]Webhook, 0)
+	resp, err := service.Do(req, &responseWebhooks)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseWebhooks, resp, nil
+}
+
+// GetWebhook - retrieve a webhook's details from the api
+func (service *Client) GetWebhook(projectID int, webhookID int) (*Webhook, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("projects/%v/webhooks/%v", projectID, webhookID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseWebhook := &Webhook{}
+	resp, err := service.Do(req, responseWebhook)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseWebhook, resp, nil
+}
+
+// NewWebhook - adds a webhook to the given project
+func (service *Client) NewWebhook(projectID int, webhook WebhookRequest) (*Webhook, *http.Response, error) {
+	req, err := service.NewRequest("POST", fmt.Sprintf("projects/%v/webhooks", projectID), webhook)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseWebhook := &Webhook{}
+	resp, err := service.Do(req, responseWebhook)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseWebhook, resp, nil
+}
+
+// UpdateWebhook - updates the url or version of a given webhook.
+func (service *Client) UpdateWebhook(projectID int, webhookID int, webhook WebhookRequest) (*Webhook, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("projects/%v/webhooks/%v", projectID, webhookID), webhook)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseWebhook := &Webhook{}
+	resp, err := service.Do(req, responseWebhook)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseWebhook, resp, nil
+}
+
+// DeleteWebhook removes a webhook from a project by webhook id.
+func (service *Client) DeleteWebhook(projectID int, webhookID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("projects/%v/webhooks/%v", projectID, webhookID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,68 @@
+package pt_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestWebhookClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("WebhookCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlProjectID := 1234
+			controlWebhookID := 5678
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteWebhook", fmt.Sprintf("projects/%v/webhooks/%v", controlProjectID, controlWebhookID), "DELETE", false, func() {
+					client.DeleteWebhook(controlProjectID, controlWebhookID)
+				}},
+				{"UpdateWebhook", fmt.Sprintf("projects/%v/webhooks/%v", controlProjectID, controlWebhookID), "PUT", true, func() {
+					client.UpdateWebhook(controlProjectID, controlWebhookID, pt.WebhookRequest{})
+				}},
+				{"NewWebhook", fmt.Sprintf("projects/%v/webhooks", controlProjectID), "POST", true, func() {
+					client.NewWebhook(controlProjectID, pt.WebhookRequest{})
+				}},
+				{"ListWebhooks", fmt.Sprintf("projects/%v/webhooks", controlProjectID), "GET", false, func() {
+					client.ListWebhooks(controlProjectID)
+				}},
+				{"GetWebhook", fmt.Sprintf("projects/%v/webhooks/%v", controlProjectID, controlWebhookID), "GET", false, func() {
+					client.GetWebhook(controlProjectID, controlWebhookID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,109 @@
+package pt
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Workspace struct {
+	Kind       string `json:"kind,omitempty"`
+	ID         int    `json:"id,omitempty"`
+	PersonID   int    `json:"person_id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	ProjectIDs []int  `json:"project_ids,omitempty"`
+}
+
+// WorkspaceRequest uses a pointer for the project ids, so that an emptied
+// list is still sent to the api.
+type WorkspaceRequest struct {
+	Name       string `json:"name,omitempty"`
+	ProjectIDs *[]int `json:"project_ids,omitempty"`
+}
+
+//go:generate counterfeiter . WorkspaceCaller
+type WorkspaceCaller interface {
+	ListWorkspaces() ([]Workspace, *http.Response, error)
+	GetWorkspace(workspaceID int) (*Workspace, *http.Response, error)
+	NewWorkspace(workspace WorkspaceRequest) (*Workspace, *http.Response, error)
+	UpdateWorkspace(workspaceID int, workspace WorkspaceRequest) (*Workspace, *http.Response, error)
+	DeleteWorkspace(workspaceID int) (*http.Response, error)
+}
+
+// ListWorkspaces - list all workspaces of the authenticated user
+func (service *Client) ListWorkspaces() ([]Workspace, *http.Response, error) {
+	req, err := service.NewRequest("GET", "my/workspaces", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseWorkspaces := make([]Workspace, 0)
+	resp, err := service.Do(req, &responseWorkspaces)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseWorkspaces, resp, nil
+}
+
+// GetWorkspace - retrieve a workspace's details from the api
+func (service *Client) GetWorkspace(workspaceID int) (*Workspace, *http.Response, error) {
+	req, err := service.NewRequest("GET", fmt.Sprintf("my/workspaces/%v", workspaceID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseWorkspace := &Workspace{}
+	resp, err := service.Do(req, responseWorkspace)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseWorkspace, resp, nil
+}
+
+// NewWorkspace - creates a workspace for the authenticated user
+func (service *Client) NewWorkspace(workspace WorkspaceRequest) (*Workspace, *http.Response, error) {
+	req, err := service.NewRequest("POST", "my/workspaces", workspace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseWorkspace := &Workspace{}
+	resp, err := service.Do(req, responseWorkspace)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseWorkspace, resp, nil
+}
+
+// UpdateWorkspace - updates the name or the projects of a given workspace.
+func (service *Client) UpdateWorkspace(workspaceID int, workspace WorkspaceRequest) (*Workspace, *http.Response, error) {
+	req, err := service.NewRequest("PUT", fmt.Sprintf("my/workspaces/%v", workspaceID), workspace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	responseWorkspace := &Workspace{}
+	resp, err := service.Do(req, responseWorkspace)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return responseWorkspace, resp, nil
+}
+
+// DeleteWorkspace removes a workspace by workspace id.
+func (service *Client) DeleteWorkspace(workspaceID int) (*http.Response, error) {
+	req, err := service.NewRequest("DELETE", fmt.Sprintf("my/workspaces/%v", workspaceID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %v", err)
+	}
+
+	resp, err := service.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("failed calling service: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package pt_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+)
+
+func TestWorkspaceClient(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("WorkspaceCaller", func(t *testing.T) {
+		t.Run("API Call Structure", func(t *testing.T) {
+			client := &pt.Client{}
+			controlWorkspaceID := 1234
+			table := []struct {
+				name          string
+				path          string
+				controlMethod string
+				hasData       bool
+				call          func()
+			}{
+				{"DeleteWorkspace", fmt.Sprintf("my/workspaces/%v", controlWorkspaceID), "DELETE", false, func() {
+					client.DeleteWorkspace(controlWorkspaceID)
+				}},
+				{"UpdateWorkspace", fmt.Sprintf("my/workspaces/%v", controlWorkspaceID), "PUT", true, func() {
+					client.UpdateWorkspace(controlWorkspaceID, pt.WorkspaceRequest{})
+				}},
+				{"NewWorkspace", "my/workspaces", "POST", true, func() {
+					client.NewWorkspace(pt.WorkspaceRequest{})
+				}},
+				{"ListWorkspaces", "my/workspaces", "GET", false, func() {
+					client.ListWorkspaces()
+				}},
+				{"GetWorkspace", fmt.Sprintf("my/workspaces/%v", controlWorkspaceID), "GET", false, func() {
+					client.GetWorkspace(controlWorkspaceID)
+				}},
+			}
+
+			for _, record := range table {
+				t.Run(record.name, func(t *testing.T) {
+					fakeRequestDoer := &ptfakes.FakeRequestDoer{}
+					client.RequestDoer = fakeRequestDoer
+					record.call()
+					Expect(fakeRequestDoer.NewRequestCallCount()).To(Equal(1),
+						"it should call the tracker API once",
+					)
+					method, path, data := fakeRequestDoer.NewRequestArgsForCall(0)
+					Expect(data == nil).NotTo(Equal(record.hasData),
+						fmt.Sprintf("when true we should have data when false we should not (%v: %v)",
+							record.hasData,
+							data,
+						),
+					)
+					Expect(path).To(Equal(record.path),
+						"path for api call is not correct",
+					)
+					Expect(method).To(Equal(record.controlMethod),
+						"method for api call is not correct",
+					)
+				})
+			}
+		})
+	})
+}
@@ -1,12 +1,29 @@
 package trackerprovider
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/accountmembers"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/accounts"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/epics"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/integrations"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/iterationoverrides"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/labels"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/me"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/projectmemberships"
 	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/projects"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/reviewtypes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/stories"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/storyreviews"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/tasks"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/validators"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/webhooks"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/workspaces"
 )
 
-type ProviderClient func(string) pt.ClientCaller
+type ProviderClient func(pt.Config) pt.ClientCaller
 
 func Create(providerClient ProviderClient) *schema.Provider {
 	return &schema.Provider{
@@ -18,13 +35,63 @@ func Create(providerClient ProviderClient) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("PVTL_TRACKER_TOKEN", ""),
 				Description: "Pivotal Tracker API access token",
 			},
+			"base_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PVTL_TRACKER_BASE_URL", pt.DefaultBaseURL),
+				ValidateFunc: validators.HTTPURL,
+				Description:  "Base URL of the Pivotal Tracker v5 API",
+			},
+			"max_retries": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: "Number of times a request is retried when Tracker responds with a 429, or a 5xx other than 501 for non-POST requests",
+			},
+			"retry_max_wait": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Maximum number of seconds to wait between two attempts of a request",
+			},
+			"requests_per_second": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Default:     0.0,
+				Description: "Maximum number of requests per second sent to Tracker by this provider, 0 means unlimited",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"pivotaltracker_project": projects.NewProjectResource(),
+			"pivotaltracker_account":            accounts.NewAccountResource(),
+			"pivotaltracker_account_member":     accountmembers.NewAccountMemberResource(),
+			"pivotaltracker_epic":               epics.NewEpicResource(),
+			"pivotaltracker_integration":        integrations.NewIntegrationResource(),
+			"pivotaltracker_iteration_override": iterationoverrides.NewIterationOverrideResource(),
+			"pivotaltracker_label":              labels.NewLabelResource(),
+			"pivotaltracker_project":            projects.NewProjectResource(),
+			"pivotaltracker_project_membership": projectmemberships.NewProjectMembershipResource(),
+			"pivotaltracker_review_type":        reviewtypes.NewReviewTypeResource(),
+			"pivotaltracker_story":              stories.NewStoryResource(),
+			"pivotaltracker_story_review":       storyreviews.NewStoryReviewResource(),
+			"pivotaltracker_task":               tasks.NewTaskResource(),
+			"pivotaltracker_webhook":            webhooks.NewWebhookResource(),
+			"pivotaltracker_workspace":          workspaces.NewWorkspaceResource(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"pivotaltracker_account_members": accountmembers.NewAccountMembersDataSource(),
+			"pivotaltracker_me":              me.NewMeDataSource(),
+			"pivotaltracker_person":          accountmembers.NewPersonDataSource(),
+			"pivotaltracker_project":         projects.NewProjectDataSource(),
+			"pivotaltracker_projects":        projects.NewProjectsDataSource(),
 		},
-		DataSourcesMap: map[string]*schema.Resource{},
 		ConfigureFunc: func(d *schema.ResourceData) (interface{}, error) {
-			return providerClient(d.Get("access_token").(string)), nil
+			return providerClient(pt.Config{
+				APIToken:          d.Get("access_token").(string),
+				BaseURL:           d.Get("base_url").(string),
+				MaxRetries:        d.Get("max_retries").(int),
+				RetryMaxWait:      time.Duration(d.Get("retry_max_wait").(int)) * time.Second,
+				RequestsPerSecond: d.Get("requests_per_second").(float64),
+			}), nil
 		},
 	}
 }
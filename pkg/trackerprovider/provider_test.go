@@ -2,8 +2,12 @@ package trackerprovider_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/schema"
 	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
 	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider"
 )
 
@@ -14,9 +18,78 @@ func TestResourceProvider(t *testing.T) {
 		Expect(provider.ResourcesMap).NotTo(BeEmpty(), "there should be some resources")
 		for k, v := range provider.ResourcesMap {
 			Expect([]string{
+				"pivotaltracker_account",
+				"pivotaltracker_account_member",
+				"pivotaltracker_epic",
+				"pivotaltracker_integration",
+				"pivotaltracker_iteration_override",
+				"pivotaltracker_label",
 				"pivotaltracker_project",
+				"pivotaltracker_project_membership",
+				"pivotaltracker_review_type",
+				"pivotaltracker_story",
+				"pivotaltracker_story_review",
+				"pivotaltracker_task",
+				"pivotaltracker_webhook",
+				"pivotaltracker_workspace",
 			}).To(ContainElement(k), "resource type is not expected")
 			Expect(v).NotTo(BeNil(), "resource value is not valid")
 		}
 	})
+
+	t.Run("should support expected data sources", func(t *testing.T) {
+		provider := trackerprovider.Create(nil)
+		expectedDataSources := []string{
+			"pivotaltracker_account_members",
+			"pivotaltracker_me",
+			"pivotaltracker_person",
+			"pivotaltracker_project",
+			"pivotaltracker_projects",
+		}
+		for _, k := range expectedDataSources {
+			Expect(provider.DataSourcesMap).To(HaveKey(k), "data source type is not registered")
+		}
+
+		for k, v := range provider.DataSourcesMap {
+			Expect(expectedDataSources).To(ContainElement(k), "data source type is not expected")
+			Expect(v).NotTo(BeNil(), "data source value is not valid")
+		}
+	})
+
+	t.Run("should configure the client from the provider arguments", func(t *testing.T) {
+		var controlConfig pt.Config
+		provider := trackerprovider.Create(func(config pt.Config) pt.ClientCaller {
+			controlConfig = config
+			return &ptfakes.FakeClientCaller{}
+		})
+		fakeData := (&schema.Resource{Schema: provider.Schema}).TestResourceData()
+		fakeData.Set("access_token", "some-token")
+		fakeData.Set("base_url", "http://localhost:8080/services/v5/")
+		fakeData.Set("max_retries", 5)
+		fakeData.Set("retry_max_wait", 10)
+		fakeData.Set("requests_per_second", 2.5)
+		client, err := provider.ConfigureFunc(fakeData)
+		Expect(err).NotTo(HaveOccurred(), "it should not error")
+		Expect(client).NotTo(BeNil(), "it should return the client")
+		Expect(controlConfig).To(Equal(pt.Config{
+			APIToken:          "some-token",
+			BaseURL:           "http://localhost:8080/services/v5/",
+			MaxRetries:        5,
+			RetryMaxWait:      10 * time.Second,
+			RequestsPerSecond: 2.5,
+		}))
+	})
+
+	t.Run("should only accept absolute http urls as base_url", func(t *testing.T) {
+		validate := trackerprovider.Create(nil).Schema["base_url"].ValidateFunc
+		for _, valid := range []string{pt.DefaultBaseURL, "http://localhost:8080/services/v5"} {
+			_, errs := validate(valid, "base_url")
+			Expect(errs).To(BeEmpty(), valid)
+		}
+
+		for _, invalid := range []string{"", "localhost:8080", "ftp://example.com", "http://[::1"} {
+			_, errs := validate(invalid, "base_url")
+			Expect(errs).NotTo(BeEmpty(), invalid)
+		}
+	})
 }
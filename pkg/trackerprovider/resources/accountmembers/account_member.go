@@ -0,0 +1,207 @@
+package accountmembers
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/attrs"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+)
+
+func NewAccountMemberResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createAccountMember,
+		Read:          readAccountMember,
+		Delete:        deleteAccountMember,
+		Update:        updateAccountMember,
+		Exists:        existsAccountMember,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createAccountMember(d *schema.ResourceData, meta interface{}) error {
+	memberRequest := pt.AccountMemberRequest{}
+	memberRequest.Admin = attrs.ConfiguredBool(d, "admin")
+	memberRequest.Email = d.Get("email").(string)
+	memberRequest.Initials = d.Get("initials").(string)
+	memberRequest.Name = d.Get("name").(string)
+	memberRequest.ProjectCreator = attrs.ConfiguredBool(d, "project_creator")
+	accountID := d.Get("account_id").(int)
+	client := meta.(pt.ClientCaller)
+	memberResponse, _, err := client.NewAccountMember(accountID, memberRequest)
+	if err != nil {
+		return fmt.Errorf("creating new account member failed: %w", err)
+	}
+
+	d.SetId(ids.Format(accountID, memberResponse.Person.ID))
+	return nil
+}
+
+func readAccountMember(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	accountID, memberID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	memberResponse, _, err := client.GetAccountMember(accountID, memberID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get account member api call failed: %w", err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("admin", pt.BoolValue(memberResponse.Admin))
+	d.Set("email", memberResponse.Person.Email)
+	d.Set("initials", memberResponse.Person.Initials)
+	d.Set("name", memberResponse.Person.Name)
+	d.Set("person_id", memberResponse.Person.ID)
+	d.Set("project_creator", pt.BoolValue(memberResponse.ProjectCreator))
+	d.SetId(ids.Format(accountID, memberResponse.Person.ID))
+	return nil
+}
+
+func deleteAccountMember(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	accountID, memberID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteAccountMember(accountID, memberID)
+	if err != nil {
+		return fmt.Errorf("delete account member failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateAccountMember(d *schema.ResourceData, meta interface{}) error {
+	memberRequest := pt.AccountMemberRequest{}
+	memberRequest.Admin = attrs.ChangedBool(d, "admin")
+	memberRequest.ProjectCreator = attrs.ChangedBool(d, "project_creator")
+	client := meta.(pt.ClientCaller)
+	accountID, memberID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	memberResponse, _, err := client.UpdateAccountMember(accountID, memberID, memberRequest)
+	if err != nil {
+		return fmt.Errorf("update account member failed: %w", err)
+	}
+
+	d.SetId(ids.Format(accountID, memberResponse.Person.ID))
+	return nil
+}
+
+func existsAccountMember(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	accountID, memberID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	member, _, err := client.GetAccountMember(accountID, memberID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get account member api call failed: %w", err)
+	}
+
+	if member.Person.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, error) {
+	values, err := ids.Parse(id, "account_id", "membership_id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the account the person should be a member of.`,
+		},
+
+		"email": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				string[255] in the request body.
+				 —  The email address of the person to add to the account. If
+				 the address does not belong to an existing Tracker user, a new
+				 person is created and invited.`,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Description: `
+				string[100] in the request body.
+				 —  The name of the person. Only used when a new person is
+				 created for the given email address.`,
+		},
+
+		"initials": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Description: `
+				string[6] in the request body.
+				 —  The initials of the person. Only used when a new person is
+				 created for the given email address.`,
+		},
+
+		"admin": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the request body.
+				 —  When true, the person is an administrator of the account.`,
+		},
+
+		"project_creator": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the request body.
+				 —  When true, the person is allowed to create projects in
+				 the account.`,
+		},
+
+		"person_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+			Description: `
+				int in the response body.
+				 —  The ID of the person holding the membership.`,
+		},
+	}
+}
@@ -0,0 +1,304 @@
+package accountmembers_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/accountmembers"
+)
+
+func TestAccountMember(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, memberResource, _ := createControlDataset()
+			for k, v := range memberResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlMemberRequest, memberResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewAccountMemberReturns(&pt.AccountMember{}, nil, fmt.Errorf("some erroor msg"))
+			err := memberResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it creates a new account member", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewAccountMemberReturns(&pt.AccountMember{
+				Person: pt.Person{ID: 5678},
+			}, nil, nil)
+			err := memberResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should set the <account_id>/<membership_id> id of the newly created resource",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				accountID, memberRequest := fakeClient.NewAccountMemberArgsForCall(0)
+				Expect(accountID).To(Equal(controlMemberRequest.AccountID))
+				Expect(memberRequest.Admin).To(Equal(controlMemberRequest.Admin))
+				Expect(memberRequest.Email).To(Equal(controlMemberRequest.Email))
+				Expect(memberRequest.Initials).To(Equal(controlMemberRequest.Initials))
+				Expect(memberRequest.Name).To(Equal(controlMemberRequest.Name))
+				Expect(memberRequest.ProjectCreator).To(Equal(controlMemberRequest.ProjectCreator))
+			})
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, memberResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteAccountMemberReturns(nil, fmt.Errorf("some erroor msg"))
+			err := memberResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing account member", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := memberResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.DeleteAccountMemberCallCount()).To(Equal(1),
+				"it should call delete exactly once",
+			)
+			accountID, memberID := fakeClient.DeleteAccountMemberArgsForCall(0)
+			Expect(accountID).To(Equal(1234),
+				"it should call delete on the account ID in the tracker api",
+			)
+			Expect(memberID).To(Equal(5678),
+				"it should call delete on the membership ID in the tracker api",
+			)
+		})
+
+		t.Run("when the id is malformed", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeData.SetId("5678")
+			err := memberResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeClient.DeleteAccountMemberCallCount()).To(Equal(0),
+				"it should not call the tracker api",
+			)
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, memberResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when account member was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountMemberReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := memberResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountMemberReturns(&pt.AccountMember{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := memberResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when account member doesnt exist", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountMemberReturns(&pt.AccountMember{}, nil, nil)
+			exists, err := memberResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false",
+			)
+		})
+
+		t.Run("when account member exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountMemberReturns(&pt.AccountMember{Person: pt.Person{ID: 5678}}, nil, nil)
+			exists, err := memberResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, memberResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when account member was deleted outside terraform", func(t *testing.T) {
+			goneData := memberResource.TestResourceData()
+			goneData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountMemberReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := memberResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountMemberReturns(&pt.AccountMember{}, nil, fmt.Errorf("some erroor msg"))
+			err := memberResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing account member", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlMemberResponse := &pt.AccountMember{
+				AccountMemberRequest: pt.AccountMemberRequest{Admin: pt.Bool(true)},
+				Person: pt.Person{
+					ID:       5678,
+					Email:    "someone@example.com",
+					Initials: "SO",
+					Name:     "Some One",
+				},
+			}
+			fakeClient.GetAccountMemberReturns(controlMemberResponse, nil, nil)
+			err := memberResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			accountID, memberID := fakeClient.GetAccountMemberArgsForCall(0)
+			Expect(accountID).To(Equal(1234))
+			Expect(memberID).To(Equal(5678))
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("account_id")).To(Equal(1234), "account_id")
+				Expect(fakeData.Get("admin")).To(BeTrue(), "admin")
+				Expect(fakeData.Get("email")).To(Equal(controlMemberResponse.Person.Email), "email")
+				Expect(fakeData.Get("initials")).To(Equal(controlMemberResponse.Person.Initials), "initials")
+				Expect(fakeData.Get("name")).To(Equal(controlMemberResponse.Person.Name), "name")
+				Expect(fakeData.Get("person_id")).To(Equal(controlMemberResponse.Person.ID), "person_id")
+				Expect(fakeData.Get("project_creator")).To(BeFalse(), "project_creator")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		_, memberResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when update fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateAccountMemberReturns(&pt.AccountMember{}, nil, fmt.Errorf("some erroor msg"))
+			err := memberResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when admin is revoked", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			existingData := memberResource.Data(&terraform.InstanceState{
+				ID: "1234/5678",
+				Attributes: map[string]string{
+					"account_id":      "1234",
+					"email":           "someone@example.com",
+					"admin":           "true",
+					"project_creator": "true",
+				},
+			})
+			existingData.Set("admin", false)
+			fakeClient.UpdateAccountMemberReturns(&pt.AccountMember{Person: pt.Person{ID: 5678}}, nil, nil)
+			err := memberResource.Update(existingData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, _, memberRequest := fakeClient.UpdateAccountMemberArgsForCall(0)
+			Expect(memberRequest.Admin).To(Equal(pt.Bool(false)),
+				"it should send an explicit false",
+			)
+			Expect(memberRequest).To(Equal(pt.AccountMemberRequest{Admin: pt.Bool(false)}),
+				"it should leave unchanged attributes out of the request",
+			)
+		})
+
+		t.Run("when it updates an existing account member", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeData.Set("admin", true)
+			fakeData.Set("project_creator", true)
+			fakeClient.UpdateAccountMemberReturns(&pt.AccountMember{Person: pt.Person{ID: 5678}}, nil, nil)
+			err := memberResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.UpdateAccountMemberCallCount()).To(Equal(1),
+				"it should call the tracker api",
+			)
+			accountID, memberID, memberRequest := fakeClient.UpdateAccountMemberArgsForCall(0)
+			Expect(accountID).To(Equal(1234))
+			Expect(memberID).To(Equal(5678))
+			Expect(memberRequest.Admin).To(Equal(pt.Bool(true)), "admin")
+			Expect(memberRequest.ProjectCreator).To(Equal(pt.Bool(true)), "project_creator")
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should keep the id of the resource",
+			)
+		})
+	})
+}
+
+func createControlDataset() (pt.AccountMemberRequest, *schema.Resource, *schema.ResourceData) {
+	memberResource := accountmembers.NewAccountMemberResource()
+	controlMember := pt.AccountMemberRequest{
+		AccountID:      1234,
+		Admin:          pt.Bool(false),
+		Email:          "someone@example.com",
+		Initials:       "SO",
+		Name:           "Some One",
+		ProjectCreator: pt.Bool(true),
+	}
+	schemaMap := map[string]interface{}{
+		"account_id":      controlMember.AccountID,
+		"admin":           pt.BoolValue(controlMember.Admin),
+		"email":           controlMember.Email,
+		"initials":        controlMember.Initials,
+		"name":            controlMember.Name,
+		"project_creator": pt.BoolValue(controlMember.ProjectCreator),
+	}
+
+	fakeData := memberResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlMember, memberResource, fakeData
+}
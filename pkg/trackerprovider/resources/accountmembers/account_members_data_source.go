@@ -0,0 +1,138 @@
+package accountmembers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+func NewAccountMembersDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read:   readAccountMembersDataSource,
+		Schema: createAccountMembersDataSourceSchema(),
+	}
+}
+
+func readAccountMembersDataSource(d *schema.ResourceData, meta interface{}) error {
+	accountID := d.Get("account_id").(int)
+	client := meta.(pt.ClientCaller)
+	membersResponse, _, err := client.ListAccountMembers(accountID)
+	if err != nil {
+		return fmt.Errorf("list account members api call failed: %w", err)
+	}
+
+	admin, filterAdmin := d.GetOkExists("admin")
+	projectCreator, filterProjectCreator := d.GetOkExists("project_creator")
+	emailDomain := strings.TrimPrefix(d.Get("email_domain").(string), "@")
+	var matches []pt.AccountMember
+	for _, member := range membersResponse {
+		if filterAdmin && pt.BoolValue(member.Admin) != admin.(bool) {
+			continue
+		}
+
+		if filterProjectCreator && pt.BoolValue(member.ProjectCreator) != projectCreator.(bool) {
+			continue
+		}
+
+		if emailDomain != "" && !strings.EqualFold(domainOf(member.Person.Email), emailDomain) {
+			continue
+		}
+		matches = append(matches, member)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Person.ID < matches[j].Person.ID
+	})
+
+	members := make([]interface{}, 0, len(matches))
+	personIDs := make([]string, 0, len(matches))
+	for _, member := range matches {
+		members = append(members, map[string]interface{}{
+			"admin":           pt.BoolValue(member.Admin),
+			"email":           member.Person.Email,
+			"initials":        member.Person.Initials,
+			"name":            member.Person.Name,
+			"person_id":       member.Person.ID,
+			"project_creator": pt.BoolValue(member.ProjectCreator),
+			"username":        member.Person.Username,
+		})
+		personIDs = append(personIDs, strconv.Itoa(member.Person.ID))
+	}
+
+	d.Set("account_members", members)
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%v:%v", accountID, strings.Join(personIDs, ",")))))
+	return nil
+}
+
+func domainOf(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return email[at+1:]
+}
+
+func createAccountMembersDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the account to list the members of.`,
+		},
+
+		"admin": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the response body.
+				 —  When given, only list the members who are admins (true)
+				 or not (false).`,
+		},
+
+		"project_creator": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the response body.
+				 —  When given, only list the members who can create
+				 projects (true) or not (false).`,
+		},
+
+		"email_domain": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: `
+				string in the response body.
+				 —  Only list the members whose email address is in this
+				 domain, ie. "example.com". Case insensitive.`,
+		},
+
+		"account_members": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"person_id":       &schema.Schema{Type: schema.TypeInt, Computed: true},
+					"name":            &schema.Schema{Type: schema.TypeString, Computed: true},
+					"email":           &schema.Schema{Type: schema.TypeString, Computed: true},
+					"initials":        &schema.Schema{Type: schema.TypeString, Computed: true},
+					"username":        &schema.Schema{Type: schema.TypeString, Computed: true},
+					"admin":           &schema.Schema{Type: schema.TypeBool, Computed: true},
+					"project_creator": &schema.Schema{Type: schema.TypeBool, Computed: true},
+				},
+			},
+			Description: `
+				List of account members in the response body.
+				 —  The matching members sorted by person id, each with the
+				 person_id, name, email, initials and username of the person,
+				 and the admin and project_creator flags of the membership.`,
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package accountmembers_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/accountmembers"
+)
+
+func TestAccountMembersDataSource(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			membersDataSource := accountmembers.NewAccountMembersDataSource()
+			for k, v := range membersDataSource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		controlMembers := []pt.AccountMember{
+			{
+				AccountMemberRequest: pt.AccountMemberRequest{ProjectCreator: pt.Bool(true)},
+				Person:               pt.Person{ID: 3, Name: "Contractor", Email: "dev@agency.example.org", Initials: "CO", Username: "contractor"},
+			},
+			{
+				AccountMemberRequest: pt.AccountMemberRequest{Admin: pt.Bool(true), ProjectCreator: pt.Bool(true)},
+				Person:               pt.Person{ID: 1, Name: "Some One", Email: "someone@Example.com", Initials: "SO", Username: "someone"},
+			},
+			{
+				Person: pt.Person{ID: 2, Name: "Sam Other", Email: "sam@example.com", Initials: "SA", Username: "sam"},
+			},
+		}
+		personIDs := func(members interface{}) []int {
+			ids := []int{}
+			for _, member := range members.([]interface{}) {
+				ids = append(ids, member.(map[string]interface{})["person_id"].(int))
+			}
+			return ids
+		}
+
+		t.Run("when list fails", func(t *testing.T) {
+			membersDataSource := accountmembers.NewAccountMembersDataSource()
+			fakeData := membersDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListAccountMembersReturns(nil, nil, fmt.Errorf("some erroor msg"))
+			err := membersDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when no filter is given", func(t *testing.T) {
+			membersDataSource := accountmembers.NewAccountMembersDataSource()
+			fakeData := membersDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListAccountMembersReturns(controlMembers, nil, nil)
+			err := membersDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.ListAccountMembersArgsForCall(0)).To(Equal(20))
+			Expect(personIDs(fakeData.Get("account_members"))).To(Equal([]int{1, 2, 3}),
+				"it should list every member sorted by person id",
+			)
+			Expect(fakeData.Get("account_members").([]interface{})[0]).To(Equal(map[string]interface{}{
+				"admin":           true,
+				"email":           "someone@Example.com",
+				"initials":        "SO",
+				"name":            "Some One",
+				"person_id":       1,
+				"project_creator": true,
+				"username":        "someone",
+			}))
+		})
+
+		t.Run("when the members are filtered by email domain", func(t *testing.T) {
+			membersDataSource := accountmembers.NewAccountMembersDataSource()
+			fakeData := membersDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeData.Set("email_domain", "example.com")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListAccountMembersReturns(controlMembers, nil, nil)
+			err := membersDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(personIDs(fakeData.Get("account_members"))).To(Equal([]int{1, 2}),
+				"it should match the domain case insensitively and not match subdomains",
+			)
+		})
+
+		t.Run("when the members are filtered by their flags", func(t *testing.T) {
+			membersDataSource := accountmembers.NewAccountMembersDataSource()
+			fakeData := membersDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeData.Set("admin", false)
+			fakeData.Set("project_creator", true)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListAccountMembersReturns(controlMembers, nil, nil)
+			err := membersDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(personIDs(fakeData.Get("account_members"))).To(Equal([]int{3}))
+		})
+	})
+}
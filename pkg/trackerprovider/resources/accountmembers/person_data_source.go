@@ -0,0 +1,161 @@
+package accountmembers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+)
+
+// personLookups are the attributes a person can be looked up by, one of
+// which has to be given.
+var personLookups = []string{"email", "username", "initials"}
+
+func NewPersonDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read:   readPersonDataSource,
+		Schema: createPersonDataSourceSchema(),
+	}
+}
+
+func readPersonDataSource(d *schema.ResourceData, meta interface{}) error {
+	var lookup, value string
+	for _, k := range personLookups {
+		if v, ok := d.GetOk(k); ok {
+			lookup, value = k, v.(string)
+			break
+		}
+	}
+
+	if lookup == "" {
+		return fmt.Errorf("one of %v must be set to look up a person", strings.Join(personLookups, ", "))
+	}
+
+	accountID := d.Get("account_id").(int)
+	client := meta.(pt.ClientCaller)
+	members, _, err := client.ListAccountMembers(accountID)
+	if err != nil {
+		return fmt.Errorf("list account members api call failed: %w", err)
+	}
+
+	var matches []pt.AccountMember
+	for _, member := range members {
+		if strings.EqualFold(personAttribute(member.Person, lookup), value) {
+			matches = append(matches, member)
+		}
+	}
+
+	switch {
+	case len(matches) == 0:
+		return fmt.Errorf("no member of account %v has %v %q", accountID, lookup, value)
+	case len(matches) > 1:
+		names := make([]string, len(matches))
+		for i, member := range matches {
+			names[i] = fmt.Sprintf("%v (%v)", member.Person.Name, member.Person.ID)
+		}
+		return fmt.Errorf("%d members of account %v have %v %q: %v, look them up by email instead",
+			len(matches), accountID, lookup, value, strings.Join(names, ", "))
+	}
+
+	member := matches[0]
+	d.Set("admin", pt.BoolValue(member.Admin))
+	d.Set("email", member.Person.Email)
+	d.Set("initials", member.Person.Initials)
+	d.Set("name", member.Person.Name)
+	d.Set("person_id", member.Person.ID)
+	d.Set("project_creator", pt.BoolValue(member.ProjectCreator))
+	d.Set("username", member.Person.Username)
+	d.SetId(ids.Format(accountID, member.Person.ID))
+	return nil
+}
+
+func personAttribute(person pt.Person, key string) string {
+	switch key {
+	case "email":
+		return person.Email
+	case "username":
+		return person.Username
+	case "initials":
+		return person.Initials
+	}
+	return ""
+}
+
+func createPersonDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the account the person is a member of.`,
+		},
+
+		"email": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"username", "initials"},
+			Description: `
+				string[255] in the response body.
+				 —  The email address to look the person up by, case
+				 insensitive.`,
+		},
+
+		"username": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"email", "initials"},
+			Description: `
+				string[100] in the response body.
+				 —  The username to look the person up by, when email is
+				 not given.`,
+		},
+
+		"initials": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"email", "username"},
+			Description: `
+				string[255] in the response body.
+				 —  The initials to look the person up by, when neither email
+				 nor username are given.`,
+		},
+
+		"person_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+			Description: `
+				int in the response body.
+				 —  The ID of the person.`,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				string[100] in the response body.
+				 —  The name of the person.`,
+		},
+
+		"admin": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+			Description: `
+				boolean in the response body.
+				 —  When true, the person is an admin of the account.`,
+		},
+
+		"project_creator": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+			Description: `
+				boolean in the response body.
+				 —  When true, the person can create projects in the account.`,
+		},
+	}
+}
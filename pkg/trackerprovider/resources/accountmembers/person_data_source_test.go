@@ -0,0 +1,127 @@
+package accountmembers_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/accountmembers"
+)
+
+func TestPersonDataSource(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			personDataSource := accountmembers.NewPersonDataSource()
+			for k, v := range personDataSource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		controlMembers := []pt.AccountMember{
+			{
+				AccountMemberRequest: pt.AccountMemberRequest{Admin: pt.Bool(true)},
+				Person:               pt.Person{ID: 1, Name: "Some One", Email: "someone@example.com", Initials: "SO", Username: "someone"},
+			},
+			{
+				AccountMemberRequest: pt.AccountMemberRequest{ProjectCreator: pt.Bool(true)},
+				Person:               pt.Person{ID: 2, Name: "Sam Other", Email: "sam@example.com", Initials: "SO", Username: "sam"},
+			},
+		}
+
+		t.Run("when list fails", func(t *testing.T) {
+			personDataSource := accountmembers.NewPersonDataSource()
+			fakeData := personDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeData.Set("email", "sam@example.com")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListAccountMembersReturns(nil, nil, fmt.Errorf("some erroor msg"))
+			err := personDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it resolves an email", func(t *testing.T) {
+			personDataSource := accountmembers.NewPersonDataSource()
+			fakeData := personDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeData.Set("email", "Sam@Example.com")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListAccountMembersReturns(controlMembers, nil, nil)
+			err := personDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.ListAccountMembersArgsForCall(0)).To(Equal(20))
+			Expect(fakeData.Id()).To(Equal("20/2"))
+			Expect(fakeData.Get("admin")).To(BeFalse(), "admin")
+			Expect(fakeData.Get("name")).To(Equal("Sam Other"), "name")
+			Expect(fakeData.Get("person_id")).To(Equal(2), "person_id")
+			Expect(fakeData.Get("project_creator")).To(BeTrue(), "project_creator")
+			Expect(fakeData.Get("username")).To(Equal("sam"), "username")
+		})
+
+		t.Run("when it resolves a username", func(t *testing.T) {
+			personDataSource := accountmembers.NewPersonDataSource()
+			fakeData := personDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeData.Set("username", "someone")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListAccountMembersReturns(controlMembers, nil, nil)
+			err := personDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Get("person_id")).To(Equal(1), "person_id")
+			Expect(fakeData.Get("admin")).To(BeTrue(), "admin")
+		})
+
+		t.Run("when several people match", func(t *testing.T) {
+			personDataSource := accountmembers.NewPersonDataSource()
+			fakeData := personDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeData.Set("initials", "SO")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListAccountMembersReturns(controlMembers, nil, nil)
+			err := personDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(MatchError(ContainSubstring("2 members of account 20 have initials \"SO\"")),
+				"it should say how many people match",
+			)
+		})
+
+		t.Run("when nobody matches", func(t *testing.T) {
+			personDataSource := accountmembers.NewPersonDataSource()
+			fakeData := personDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeData.Set("email", "nobody@example.com")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListAccountMembersReturns(controlMembers, nil, nil)
+			err := personDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(MatchError(ContainSubstring("no member of account 20 has email \"nobody@example.com\"")))
+		})
+
+		t.Run("when no lookup is given", func(t *testing.T) {
+			personDataSource := accountmembers.NewPersonDataSource()
+			fakeData := personDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := personDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeClient.Invocations()).To(BeEmpty(),
+				"it should not call the tracker API",
+			)
+		})
+	})
+}
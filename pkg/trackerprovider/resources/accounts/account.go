@@ -0,0 +1,138 @@
+package accounts
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+// NewAccountResource returns a resource adopting an existing account.
+// Tracker accounts can not be created or deleted through the api, so the
+// account has to be imported and destroying it only forgets it.
+func NewAccountResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createAccount,
+		Read:          readAccount,
+		Delete:        deleteAccount,
+		Update:        updateAccount,
+		Exists:        existsAccount,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createAccount(d *schema.ResourceData, meta interface{}) error {
+	return fmt.Errorf("accounts can not be created through the tracker api, adopt an existing one with: terraform import pivotaltracker_account.<name> <account_id>")
+}
+
+func readAccount(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("conversion of id failed: %v", err)
+	}
+
+	accountResponse, _, err := client.GetAccount(id)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get account api call failed: %w", err)
+	}
+
+	d.Set("days_left", accountResponse.DaysLeft)
+	d.Set("name", accountResponse.Name)
+	d.Set("plan", accountResponse.Plan)
+	d.Set("status", accountResponse.Status)
+	d.SetId(strconv.Itoa(accountResponse.ID))
+	return nil
+}
+
+func deleteAccount(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func updateAccount(d *schema.ResourceData, meta interface{}) error {
+	accountRequest := pt.AccountRequest{}
+	accountRequest.Name = d.Get("name").(string)
+	client := meta.(pt.ClientCaller)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("conversion of id failed: %v", err)
+	}
+
+	accountResponse, _, err := client.UpdateAccount(id, accountRequest)
+	if err != nil {
+		return fmt.Errorf("update account failed: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(accountResponse.ID))
+	return nil
+}
+
+func existsAccount(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return false, fmt.Errorf("conversion of id failed: %v", err)
+	}
+
+	account, _, err := client.GetAccount(id)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get account api call failed: %w", err)
+	}
+
+	if account.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			Description: `
+				string[255] in the request body.
+				 —  The name of the account.`,
+		},
+
+		"plan": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				string in the response body.
+				 —  The name of the plan the account is subscribed to.`,
+		},
+
+		"status": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				enumerated string in the response body.
+				 —  The status of the account.
+				 Valid enumeration values: active, suspended, deleted, limited, trial`,
+		},
+
+		"days_left": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+			Description: `
+				int in the response body.
+				 —  The number of days left in the trial of the account.`,
+		},
+	}
+}
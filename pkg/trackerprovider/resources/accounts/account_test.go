@@ -0,0 +1,188 @@
+package accounts_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/accounts"
+)
+
+func TestAccount(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, accountResource, _ := createControlDataset()
+			for k, v := range accountResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		_, accountResource, fakeData := createControlDataset()
+		t.Run("when an account is not imported", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := accountResource.Create(fakeData, fakeClient)
+			Expect(err).To(MatchError(ContainSubstring("terraform import")),
+				"it should point to the import",
+			)
+			Expect(fakeData.Id()).To(BeEmpty())
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, accountResource, fakeData := createControlDataset()
+		fakeData.SetId("1234")
+		t.Run("when it forgets an adopted account", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := accountResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(BeEmpty(),
+				"it should remove the account from the state",
+			)
+			Expect(fakeClient.Invocations()).To(BeEmpty(),
+				"it should not call the tracker API",
+			)
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, accountResource, fakeData := createControlDataset()
+		fakeData.SetId("1234")
+		t.Run("when account was closed outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := accountResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountReturns(&pt.Account{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := accountResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when account exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountReturns(&pt.Account{ID: 1234}, nil, nil)
+			exists, err := accountResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, accountResource, fakeData := createControlDataset()
+		fakeData.SetId("1234")
+		t.Run("when account was closed outside terraform", func(t *testing.T) {
+			goneData := accountResource.TestResourceData()
+			goneData.SetId("1234")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := accountResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetAccountReturns(&pt.Account{}, nil, fmt.Errorf("some erroor msg"))
+			err := accountResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing account", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlAccountResponse := &pt.Account{
+				ID:             1234,
+				AccountRequest: pt.AccountRequest{Name: "acme"},
+				Plan:           "Startup",
+				Status:         "trial",
+				DaysLeft:       12,
+			}
+			fakeClient.GetAccountReturns(controlAccountResponse, nil, nil)
+			err := accountResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("days_left")).To(Equal(12), "days_left")
+				Expect(fakeData.Get("name")).To(Equal(controlAccountResponse.Name), "name")
+				Expect(fakeData.Get("plan")).To(Equal(controlAccountResponse.Plan), "plan")
+				Expect(fakeData.Get("status")).To(Equal(controlAccountResponse.Status), "status")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		controlAccountRequest, accountResource, fakeData := createControlDataset()
+		fakeData.SetId("1234")
+		t.Run("when update fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateAccountReturns(&pt.Account{}, nil, fmt.Errorf("some erroor msg"))
+			err := accountResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it renames an account", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateAccountReturns(&pt.Account{ID: 1234}, nil, nil)
+			err := accountResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			accountID, accountRequest := fakeClient.UpdateAccountArgsForCall(0)
+			Expect(accountID).To(Equal(1234))
+			Expect(accountRequest).To(Equal(controlAccountRequest))
+		})
+	})
+}
+
+func createControlDataset() (pt.AccountRequest, *schema.Resource, *schema.ResourceData) {
+	accountResource := accounts.NewAccountResource()
+	controlAccount := pt.AccountRequest{
+		Name: "acme",
+	}
+	schemaMap := map[string]interface{}{
+		"name": controlAccount.Name,
+	}
+
+	fakeData := accountResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlAccount, accountResource, fakeData
+}
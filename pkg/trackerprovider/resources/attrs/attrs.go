@@ -0,0 +1,24 @@
+package attrs
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+// ConfiguredBool returns the value of a bool attribute only when it is set
+// in the configuration, so that tracker applies its own default otherwise.
+func ConfiguredBool(d *schema.ResourceData, key string) *bool {
+	if v, ok := d.GetOkExists(key); ok {
+		return pt.Bool(v.(bool))
+	}
+	return nil
+}
+
+// ChangedBool returns the value of a bool attribute only when it changed.
+// This is what allows an update to send an explicit false to tracker.
+func ChangedBool(d *schema.ResourceData, key string) *bool {
+	if d.HasChange(key) {
+		return pt.Bool(d.Get(key).(bool))
+	}
+	return nil
+}
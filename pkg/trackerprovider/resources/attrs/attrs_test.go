@@ -0,0 +1,46 @@
+package attrs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/attrs"
+)
+
+func TestAttrs(t *testing.T) {
+	RegisterTestingT(t)
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"public":       &schema.Schema{Type: schema.TypeBool, Optional: true},
+			"atom_enabled": &schema.Schema{Type: schema.TypeBool, Optional: true},
+		},
+	}
+
+	t.Run("ConfiguredBool", func(t *testing.T) {
+		d := resource.TestResourceData()
+		d.Set("public", false)
+		Expect(attrs.ConfiguredBool(d, "public")).To(Equal(pt.Bool(false)),
+			"it should return an explicitly set false",
+		)
+		Expect(attrs.ConfiguredBool(d, "atom_enabled")).To(BeNil(),
+			"it should return nil when unset",
+		)
+	})
+
+	t.Run("ChangedBool", func(t *testing.T) {
+		d := resource.Data(&terraform.InstanceState{
+			ID:         "1234",
+			Attributes: map[string]string{"public": "true", "atom_enabled": "true"},
+		})
+		d.Set("public", false)
+		Expect(attrs.ChangedBool(d, "public")).To(Equal(pt.Bool(false)),
+			"it should return a value changed to false",
+		)
+		Expect(attrs.ChangedBool(d, "atom_enabled")).To(BeNil(),
+			"it should return nil when unchanged",
+		)
+	})
+}
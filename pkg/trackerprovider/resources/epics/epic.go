@@ -0,0 +1,235 @@
+package epics
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/labels"
+)
+
+func NewEpicResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createEpic,
+		Read:          readEpic,
+		Delete:        deleteEpic,
+		Update:        updateEpic,
+		Exists:        existsEpic,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createEpic(d *schema.ResourceData, meta interface{}) error {
+	epicRequest := pt.EpicRequest{}
+	epicRequest.AfterID = d.Get("after_id").(int)
+	epicRequest.BeforeID = d.Get("before_id").(int)
+	epicRequest.Description = d.Get("description").(string)
+	epicRequest.Name = d.Get("name").(string)
+	if label := d.Get("label").(string); label != "" {
+		epicRequest.Label = &pt.LabelRequest{Name: label}
+	}
+
+	projectID := d.Get("project_id").(int)
+	client := meta.(pt.ClientCaller)
+	epicResponse, _, err := client.NewEpic(projectID, epicRequest)
+	if err != nil {
+		return fmt.Errorf("creating new epic failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, epicResponse.ID))
+	return readEpic(d, meta)
+}
+
+func readEpic(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, epicID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	epicResponse, _, err := client.GetEpic(projectID, epicID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get epic api call failed: %w", err)
+	}
+
+	d.Set("description", epicResponse.Description)
+	d.Set("label", epicResponse.Label.Name)
+	d.Set("label_id", epicResponse.Label.ID)
+	d.Set("name", epicResponse.Name)
+	d.Set("project_id", projectID)
+	d.Set("url", epicResponse.URL)
+	d.SetId(ids.Format(projectID, epicResponse.ID))
+	return nil
+}
+
+func deleteEpic(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, epicID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteEpic(projectID, epicID)
+	if err != nil {
+		return fmt.Errorf("delete epic failed: %w", err)
+	}
+
+	return nil
+}
+
+// updateEpic renames the label linked to the epic rather than pointing the
+// epic at another label, so the label keeps its id and its stories.
+func updateEpic(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, epicID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("label") {
+		labelRequest := pt.LabelRequest{Name: d.Get("label").(string)}
+		_, _, err := client.UpdateLabel(projectID, d.Get("label_id").(int), labelRequest)
+		if err != nil {
+			return fmt.Errorf("update epic label failed: %w", err)
+		}
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("before_id") || d.HasChange("after_id") {
+		epicRequest := pt.EpicRequest{}
+		epicRequest.Description = d.Get("description").(string)
+		epicRequest.ClearDescription = d.HasChange("description") && epicRequest.Description == ""
+		epicRequest.Name = d.Get("name").(string)
+		if d.HasChange("before_id") {
+			epicRequest.BeforeID = d.Get("before_id").(int)
+		}
+
+		if d.HasChange("after_id") {
+			epicRequest.AfterID = d.Get("after_id").(int)
+		}
+
+		_, _, err := client.UpdateEpic(projectID, epicID, epicRequest)
+		if err != nil {
+			return fmt.Errorf("update epic failed: %w", err)
+		}
+	}
+
+	return readEpic(d, meta)
+}
+
+func existsEpic(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, epicID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	epic, _, err := client.GetEpic(projectID, epicID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get epic api call failed: %w", err)
+	}
+
+	if epic.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, error) {
+	values, err := ids.Parse(id, "project_id", "epic_id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project the epic belongs to.`,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			Description: `
+				string[5000] in the request body.
+				 —  The name of the epic.`,
+		},
+
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: `
+				string[20000] in the request body.
+				 —  In-depth explanation of the epic's goals, scope, etc.`,
+		},
+
+		"label": &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: labels.SuppressNameCaseDiff,
+			Description: `
+				string[255] in the request body.
+				 —  The name of the label linked to the epic. Stories with
+				 this label belong to the epic. Defaults to the epic's name
+				 when not given. Changing it renames the existing label.`,
+		},
+
+		"before_id": &schema.Schema{
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"after_id"},
+			Description: `
+				int in the request body.
+				 —  ID of the epic that the epic should be placed before.
+				 Changing it moves the epic.`,
+		},
+
+		"after_id": &schema.Schema{
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"before_id"},
+			Description: `
+				int in the request body.
+				 —  ID of the epic that the epic should be placed after.
+				 Changing it moves the epic.`,
+		},
+
+		"label_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+			Description: `
+				int in the response body.
+				 —  The ID of the label linked to the epic.`,
+		},
+
+		"url": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				string in the response body.
+				 —  The url of the epic in the tracker web interface.`,
+		},
+	}
+}
@@ -0,0 +1,312 @@
+package epics_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/epics"
+)
+
+func TestEpic(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, epicResource, _ := createControlDataset()
+			for k, v := range epicResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+
+		t.Run("Should not replace the epic when the label changes", func(t *testing.T) {
+			_, epicResource, _ := createControlDataset()
+			Expect(epicResource.Schema["label"].ForceNew).To(BeFalse())
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlEpicRequest, epicResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewEpicReturns(&pt.Epic{}, nil, fmt.Errorf("some erroor msg"))
+			err := epicResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it creates a new epic", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewEpicReturns(&pt.Epic{ID: 5678}, nil, nil)
+			fakeClient.GetEpicReturns(&pt.Epic{
+				ID:    5678,
+				Name:  controlEpicRequest.Name,
+				URL:   "https://www.pivotaltracker.com/epic/show/5678",
+				Label: pt.Label{ID: 91011, LabelRequest: pt.LabelRequest{Name: "q3-onboarding"}},
+			}, nil, nil)
+			err := epicResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should set the <project_id>/<epic_id> id of the newly created resource",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, epicRequest := fakeClient.NewEpicArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(epicRequest).To(Equal(controlEpicRequest))
+			})
+
+			t.Run("it should set the computed values of the epic", func(t *testing.T) {
+				Expect(fakeData.Get("label_id")).To(Equal(91011), "label_id")
+				Expect(fakeData.Get("url")).To(Equal("https://www.pivotaltracker.com/epic/show/5678"), "url")
+			})
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, epicResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteEpicReturns(nil, fmt.Errorf("some erroor msg"))
+			err := epicResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing epic", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := epicResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, epicID := fakeClient.DeleteEpicArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(epicID).To(Equal(5678))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, epicResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when epic was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetEpicReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := epicResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetEpicReturns(&pt.Epic{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := epicResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when epic exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetEpicReturns(&pt.Epic{ID: 5678}, nil, nil)
+			exists, err := epicResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, epicResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when epic was deleted outside terraform", func(t *testing.T) {
+			goneData := epicResource.TestResourceData()
+			goneData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetEpicReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := epicResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetEpicReturns(&pt.Epic{}, nil, fmt.Errorf("some erroor msg"))
+			err := epicResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing epic", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlEpicResponse := &pt.Epic{
+				ID:          5678,
+				Name:        "Q3 Onboarding",
+				Description: "make signing up painless",
+				URL:         "https://www.pivotaltracker.com/epic/show/5678",
+				Label:       pt.Label{ID: 91011, LabelRequest: pt.LabelRequest{Name: "q3-onboarding"}},
+			}
+			fakeClient.GetEpicReturns(controlEpicResponse, nil, nil)
+			err := epicResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("description")).To(Equal(controlEpicResponse.Description), "description")
+				Expect(fakeData.Get("label")).To(Equal(controlEpicResponse.Label.Name), "label")
+				Expect(fakeData.Get("label_id")).To(Equal(controlEpicResponse.Label.ID), "label_id")
+				Expect(fakeData.Get("name")).To(Equal(controlEpicResponse.Name), "name")
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+				Expect(fakeData.Get("url")).To(Equal(controlEpicResponse.URL), "url")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Run("when update fails", func(t *testing.T) {
+			_, epicResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateEpicReturns(&pt.Epic{}, nil, fmt.Errorf("some erroor msg"))
+			err := epicResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when the label changes", func(t *testing.T) {
+			epicResource := epics.NewEpicResource()
+			fakeData := epicResource.TestResourceData()
+			fakeData.SetId("1234/5678")
+			fakeData.Set("label_id", 91011)
+			fakeData.Set("label", "q4-onboarding")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetEpicReturns(&pt.Epic{ID: 5678}, nil, nil)
+			err := epicResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.UpdateLabelCallCount()).To(Equal(1),
+				"it should rename the linked label",
+			)
+			projectID, labelID, labelRequest := fakeClient.UpdateLabelArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(labelID).To(Equal(91011),
+				"it should update the label in place",
+			)
+			Expect(labelRequest.Name).To(Equal("q4-onboarding"))
+			Expect(fakeClient.UpdateEpicCallCount()).To(Equal(0),
+				"it should leave the epic itself alone",
+			)
+		})
+
+		t.Run("when the name changes", func(t *testing.T) {
+			epicResource := epics.NewEpicResource()
+			fakeData := epicResource.TestResourceData()
+			fakeData.SetId("1234/5678")
+			fakeData.Set("name", "Q4 Onboarding")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetEpicReturns(&pt.Epic{ID: 5678}, nil, nil)
+			err := epicResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, epicID, epicRequest := fakeClient.UpdateEpicArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(epicID).To(Equal(5678))
+			Expect(epicRequest.Name).To(Equal("Q4 Onboarding"))
+			Expect(fakeClient.UpdateLabelCallCount()).To(Equal(0),
+				"it should not touch the label",
+			)
+		})
+
+		t.Run("when the description is removed", func(t *testing.T) {
+			epicResource := epics.NewEpicResource()
+			fakeData := epicResource.TestResourceData()
+			fakeData.SetId("1234/5678")
+			fakeData.Set("name", "Q4 Onboarding")
+			fakeData.Set("description", "")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetEpicReturns(&pt.Epic{ID: 5678}, nil, nil)
+			err := epicResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, _, epicRequest := fakeClient.UpdateEpicArgsForCall(0)
+			Expect(epicRequest.ClearDescription).To(BeTrue(),
+				"it should send the emptied description",
+			)
+		})
+
+		t.Run("when the epic is moved", func(t *testing.T) {
+			epicResource := epics.NewEpicResource()
+			fakeData := epicResource.TestResourceData()
+			fakeData.SetId("1234/5678")
+			fakeData.Set("after_id", 91011)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetEpicReturns(&pt.Epic{ID: 5678}, nil, nil)
+			err := epicResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.UpdateEpicCallCount()).To(Equal(1),
+				"it should call the tracker api",
+			)
+			_, _, epicRequest := fakeClient.UpdateEpicArgsForCall(0)
+			Expect(epicRequest.AfterID).To(Equal(91011),
+				"it should send the new placement",
+			)
+			Expect(epicRequest.BeforeID).To(BeZero(),
+				"it should leave out the placement that did not change",
+			)
+		})
+	})
+}
+
+func createControlDataset() (pt.EpicRequest, *schema.Resource, *schema.ResourceData) {
+	epicResource := epics.NewEpicResource()
+	controlEpic := pt.EpicRequest{
+		Name:        "Q3 Onboarding",
+		Description: "make signing up painless",
+		Label:       &pt.LabelRequest{Name: "q3-onboarding"},
+		AfterID:     42,
+	}
+	schemaMap := map[string]interface{}{
+		"after_id":    controlEpic.AfterID,
+		"description": controlEpic.Description,
+		"label":       controlEpic.Label.Name,
+		"name":        controlEpic.Name,
+		"project_id":  1234,
+	}
+
+	fakeData := epicResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlEpic, epicResource, fakeData
+}
@@ -0,0 +1,36 @@
+package ids
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Format joins the given ids into a "/" separated resource id, ie.
+// "<account_id>/<membership_id>".
+func Format(ids ...int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, "/")
+}
+
+// Parse splits a "/" separated resource id into its numeric parts. The
+// names describe each expected part and are used in error messages.
+func Parse(id string, names ...string) ([]int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != len(names) {
+		return nil, fmt.Errorf("unexpected id %q, expected <%v>", id, strings.Join(names, ">/<"))
+	}
+
+	values := make([]int, len(parts))
+	for i, part := range parts {
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("conversion of %v failed: %v", names[i], err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
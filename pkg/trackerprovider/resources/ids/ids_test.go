@@ -0,0 +1,42 @@
+package ids_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+)
+
+func TestIDs(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Format", func(t *testing.T) {
+		Expect(ids.Format(1234, 5678)).To(Equal("1234/5678"))
+		Expect(ids.Format(1234)).To(Equal("1234"))
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		t.Run("when the id is well formed", func(t *testing.T) {
+			values, err := ids.Parse("1234/5678", "account_id", "membership_id")
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(values).To(Equal([]int{1234, 5678}),
+				"it should return each part of the id",
+			)
+		})
+
+		t.Run("when the id has the wrong number of parts", func(t *testing.T) {
+			_, err := ids.Parse("1234", "account_id", "membership_id")
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when a part is not numeric", func(t *testing.T) {
+			_, err := ids.Parse("1234/abc", "account_id", "membership_id")
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+	})
+}
@@ -0,0 +1,411 @@
+package integrations
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/attrs"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/validators"
+)
+
+var integrationTypes = []string{"bugzilla", "github", "jira", "other", "zendesk"}
+
+// sensitiveSettings are never returned by tracker, so they are kept as
+// they are in the state instead of being read back.
+var sensitiveSettings = []string{"api_password", "zendesk_user_password"}
+
+func NewIntegrationResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createIntegration,
+		Read:          readIntegration,
+		Delete:        deleteIntegration,
+		Update:        updateIntegration,
+		Exists:        existsIntegration,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createIntegration(d *schema.ResourceData, meta interface{}) error {
+	integrationRequest := pt.IntegrationRequest{}
+	integrationRequest.Active = attrs.ConfiguredBool(d, "active")
+	integrationRequest.CanImport = attrs.ConfiguredBool(d, "can_import")
+	integrationRequest.CommentsPrivate = attrs.ConfiguredBool(d, "comments_private")
+	integrationRequest.Name = d.Get("name").(string)
+	integrationRequest.Type = d.Get("type").(string)
+	integrationRequest.UpdateComments = attrs.ConfiguredBool(d, "update_comments")
+	settings, err := getSettings(d)
+	if err != nil {
+		return err
+	}
+	applySettings(&integrationRequest, settings)
+
+	projectID := d.Get("project_id").(int)
+	client := meta.(pt.ClientCaller)
+	integrationResponse, _, err := client.NewIntegration(projectID, integrationRequest)
+	if err != nil {
+		return fmt.Errorf("creating new integration failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, integrationResponse.ID))
+	return nil
+}
+
+func readIntegration(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, integrationID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	integrationResponse, _, err := client.GetIntegration(projectID, integrationID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get integration api call failed: %w", err)
+	}
+
+	integrationType := strings.TrimSuffix(integrationResponse.Kind, "_integration")
+	_, hasSettings := settingsSchemas()[integrationType]
+	var stateSettings map[string]interface{}
+	if hasSettings {
+		if list := d.Get(integrationType).([]interface{}); len(list) > 0 && list[0] != nil {
+			stateSettings = list[0].(map[string]interface{})
+		}
+	}
+
+	d.Set("active", pt.BoolValue(integrationResponse.Active))
+	d.Set("can_import", pt.BoolValue(integrationResponse.CanImport))
+	d.Set("comments_private", pt.BoolValue(integrationResponse.CommentsPrivate))
+	d.Set("name", integrationResponse.Name)
+	d.Set("project_id", projectID)
+	d.Set("type", integrationType)
+	d.Set("update_comments", pt.BoolValue(integrationResponse.UpdateComments))
+	if hasSettings {
+		d.Set(integrationType, []interface{}{readSettings(integrationType, integrationResponse, stateSettings)})
+	}
+	d.SetId(ids.Format(projectID, integrationResponse.ID))
+	return nil
+}
+
+func deleteIntegration(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, integrationID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteIntegration(projectID, integrationID)
+	if err != nil {
+		return fmt.Errorf("delete integration failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateIntegration(d *schema.ResourceData, meta interface{}) error {
+	integrationRequest := pt.IntegrationRequest{}
+	integrationRequest.Active = attrs.ChangedBool(d, "active")
+	integrationRequest.CanImport = attrs.ChangedBool(d, "can_import")
+	integrationRequest.CommentsPrivate = attrs.ChangedBool(d, "comments_private")
+	integrationRequest.Name = d.Get("name").(string)
+	integrationRequest.UpdateComments = attrs.ChangedBool(d, "update_comments")
+	settings, err := getSettings(d)
+	if err != nil {
+		return err
+	}
+	applySettings(&integrationRequest, settings)
+
+	client := meta.(pt.ClientCaller)
+	projectID, integrationID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	integrationResponse, _, err := client.UpdateIntegration(projectID, integrationID, integrationRequest)
+	if err != nil {
+		return fmt.Errorf("update integration failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, integrationResponse.ID))
+	return nil
+}
+
+func existsIntegration(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, integrationID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	integration, _, err := client.GetIntegration(projectID, integrationID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get integration api call failed: %w", err)
+	}
+
+	if integration.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, error) {
+	values, err := ids.Parse(id, "project_id", "integration_id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+// getSettings returns the settings block matching the integration type,
+// and errors when that block is missing. GitHub integrations are set up
+// from the GitHub side, so they have no settings block.
+func getSettings(d *schema.ResourceData) (map[string]interface{}, error) {
+	integrationType := d.Get("type").(string)
+	if _, ok := settingsSchemas()[integrationType]; !ok {
+		return nil, nil
+	}
+
+	list := d.Get(integrationType).([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil, fmt.Errorf("a %v block is required for integrations of type %v", integrationType, integrationType)
+	}
+
+	return list[0].(map[string]interface{}), nil
+}
+
+func applySettings(integrationRequest *pt.IntegrationRequest, settings map[string]interface{}) {
+	fields := map[string]*string{
+		"api_password":          &integrationRequest.APIPassword,
+		"api_username":          &integrationRequest.APIUsername,
+		"base_url":              &integrationRequest.BaseURL,
+		"component":             &integrationRequest.Component,
+		"filter_id":             &integrationRequest.FilterID,
+		"import_api_url":        &integrationRequest.ImportAPIURL,
+		"product":               &integrationRequest.Product,
+		"zendesk_user_email":    &integrationRequest.ZendeskUserEmail,
+		"zendesk_user_password": &integrationRequest.ZendeskUserPassword,
+	}
+	for k, v := range settings {
+		if field, ok := fields[k]; ok {
+			*field = v.(string)
+		}
+	}
+}
+
+// readSettings builds the settings block of the given integration type from
+// the api response, carrying the sensitive settings over from the state.
+func readSettings(integrationType string, integration *pt.Integration, stateSettings map[string]interface{}) map[string]interface{} {
+	values := map[string]interface{}{
+		"api_username":       integration.APIUsername,
+		"base_url":           integration.BaseURL,
+		"component":          integration.Component,
+		"filter_id":          integration.FilterID,
+		"import_api_url":     integration.ImportAPIURL,
+		"product":            integration.Product,
+		"zendesk_user_email": integration.ZendeskUserEmail,
+	}
+	for _, k := range sensitiveSettings {
+		values[k] = stateSettings[k]
+	}
+
+	settings := map[string]interface{}{}
+	for k := range settingsSchemas()[integrationType] {
+		if v, ok := values[k]; ok && v != nil {
+			settings[k] = v
+		}
+	}
+	return settings
+}
+
+func settingsSchemas() map[string]map[string]*schema.Schema {
+	return map[string]map[string]*schema.Schema{
+		"bugzilla": map[string]*schema.Schema{
+			"base_url":       baseURLSchema(),
+			"import_api_url": importAPIURLSchema(),
+			"api_username": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The bugzilla user tracker signs in as.",
+			},
+			"api_password": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of the bugzilla user. Never returned by tracker.",
+			},
+			"product": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The bugzilla product bugs are imported from.",
+			},
+			"component": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The bugzilla component bugs are imported from.",
+			},
+		},
+		"jira": map[string]*schema.Schema{
+			"base_url": baseURLSchema(),
+			"api_username": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The jira user tracker signs in as.",
+			},
+			"api_password": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the jira user. Never returned by tracker.",
+			},
+			"filter_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the jira filter issues are imported from.",
+			},
+		},
+		"other": map[string]*schema.Schema{
+			"base_url":       baseURLSchema(),
+			"import_api_url": importAPIURLSchema(),
+		},
+		"zendesk": map[string]*schema.Schema{
+			"base_url": baseURLSchema(),
+			"zendesk_user_email": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The email address of the zendesk user tracker signs in as.",
+			},
+			"zendesk_user_password": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the zendesk user. Never returned by tracker.",
+			},
+		},
+	}
+}
+
+func baseURLSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validators.HTTPURL,
+		Description:  "The url of the external tool, used to link stories to it.",
+	}
+}
+
+func importAPIURLSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validators.HTTPURL,
+		Description:  "The url tracker imports items from.",
+	}
+}
+
+func createSchema() map[string]*schema.Schema {
+	integrationSchema := map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project the integration belongs to.`,
+		},
+
+		"type": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validators.StringInSlice(integrationTypes),
+			Description: `
+				enumerated string in the request body.
+				 —  The kind of external tool. The settings go in the block
+				 of the same name, except for github which has none.
+				 Valid enumeration values: bugzilla, github, jira, other, zendesk`,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			Description: `
+				string[255] in the request body.
+				 —  The name of the integration as shown in tracker.`,
+		},
+
+		"active": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the request body.
+				 —  When false, tracker stops talking to the external tool.`,
+		},
+
+		"can_import": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the request body.
+				 —  When true, items of the external tool can be imported
+				 as stories.`,
+		},
+
+		"comments_private": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the request body.
+				 —  When true, comments added to the external tool by
+				 tracker are private.`,
+		},
+
+		"update_comments": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the request body.
+				 —  When true, tracker comments on items of the external
+				 tool when their stories change.`,
+		},
+	}
+
+	settingsByType := settingsSchemas()
+	for integrationType, settings := range settingsByType {
+		var conflicts []string
+		for other := range settingsByType {
+			if other != integrationType {
+				conflicts = append(conflicts, other)
+			}
+		}
+		sort.Strings(conflicts)
+
+		integrationSchema[integrationType] = &schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: conflicts,
+			Elem:          &schema.Resource{Schema: settings},
+			Description: fmt.Sprintf(`
+				block in the request body.
+				 —  The settings of a %v integration. Required when type
+				 is %v.`, integrationType, integrationType),
+		}
+	}
+	return integrationSchema
+}
@@ -0,0 +1,297 @@
+package integrations_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/integrations"
+)
+
+func TestIntegration(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, integrationResource, _ := createControlDataset()
+			for k, v := range integrationResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+				if block, ok := v.Elem.(*schema.Resource); ok {
+					for nk, nv := range block.Schema {
+						Expect(nv.Description).NotTo(BeEmpty(),
+							fmt.Sprintf("we shouldnt add elements without having a description (%v.%v)", k, nk),
+						)
+					}
+				}
+			}
+		})
+
+		t.Run("Should mark credentials as sensitive", func(t *testing.T) {
+			_, integrationResource, _ := createControlDataset()
+			for _, kind := range []string{"bugzilla", "jira"} {
+				block := integrationResource.Schema[kind].Elem.(*schema.Resource)
+				Expect(block.Schema["api_password"].Sensitive).To(BeTrue(), kind)
+			}
+			block := integrationResource.Schema["zendesk"].Elem.(*schema.Resource)
+			Expect(block.Schema["zendesk_user_password"].Sensitive).To(BeTrue(), "zendesk")
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlIntegrationRequest, integrationResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewIntegrationReturns(&pt.Integration{}, nil, fmt.Errorf("some erroor msg"))
+			err := integrationResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when the block of the type is missing", func(t *testing.T) {
+			badData := integrationResource.TestResourceData()
+			badData.Set("project_id", 1234)
+			badData.Set("type", "zendesk")
+			badData.Set("name", "support")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := integrationResource.Create(badData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeClient.NewIntegrationCallCount()).To(BeZero(),
+				"it should not call the api",
+			)
+		})
+
+		t.Run("when it creates a github integration", func(t *testing.T) {
+			githubData := integrationResource.TestResourceData()
+			githubData.Set("project_id", 1234)
+			githubData.Set("type", "github")
+			githubData.Set("name", "code")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewIntegrationReturns(&pt.Integration{ID: 5678}, nil, nil)
+			err := integrationResource.Create(githubData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not need a settings block",
+			)
+		})
+
+		t.Run("when it creates a new integration", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewIntegrationReturns(&pt.Integration{ID: 5678}, nil, nil)
+			err := integrationResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should set the <project_id>/<integration_id> id of the newly created resource",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, integrationRequest := fakeClient.NewIntegrationArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(integrationRequest).To(Equal(controlIntegrationRequest))
+			})
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, integrationResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteIntegrationReturns(nil, fmt.Errorf("some erroor msg"))
+			err := integrationResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing integration", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := integrationResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, integrationID := fakeClient.DeleteIntegrationArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(integrationID).To(Equal(5678))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, integrationResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when integration was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetIntegrationReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := integrationResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetIntegrationReturns(&pt.Integration{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := integrationResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when integration exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetIntegrationReturns(&pt.Integration{ID: 5678}, nil, nil)
+			exists, err := integrationResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		t.Run("when integration was deleted outside terraform", func(t *testing.T) {
+			_, integrationResource, goneData := createControlDataset()
+			goneData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetIntegrationReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := integrationResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			_, integrationResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetIntegrationReturns(&pt.Integration{}, nil, fmt.Errorf("some erroor msg"))
+			err := integrationResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing integration", func(t *testing.T) {
+			_, integrationResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlIntegrationResponse := &pt.Integration{
+				Kind: "jira_integration",
+				ID:   5678,
+				IntegrationRequest: pt.IntegrationRequest{
+					Name:        "issues",
+					Active:      pt.Bool(true),
+					BaseURL:     "https://example.atlassian.net",
+					APIUsername: "tracker-bot",
+					FilterID:    "10042",
+				},
+			}
+			fakeClient.GetIntegrationReturns(controlIntegrationResponse, nil, nil)
+			err := integrationResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("active")).To(BeTrue(), "active")
+				Expect(fakeData.Get("name")).To(Equal(controlIntegrationResponse.Name), "name")
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+				Expect(fakeData.Get("type")).To(Equal("jira"), "type")
+				jira := fakeData.Get("jira").([]interface{})[0].(map[string]interface{})
+				Expect(jira["api_username"]).To(Equal("tracker-bot"), "jira.api_username")
+				Expect(jira["base_url"]).To(Equal("https://example.atlassian.net"), "jira.base_url")
+				Expect(jira["filter_id"]).To(Equal("10042"), "jira.filter_id")
+			})
+
+			t.Run("it should keep the password the API does not return", func(t *testing.T) {
+				jira := fakeData.Get("jira").([]interface{})[0].(map[string]interface{})
+				Expect(jira["api_password"]).To(Equal("s3cr3t"))
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Run("when update fails", func(t *testing.T) {
+			_, integrationResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateIntegrationReturns(&pt.Integration{}, nil, fmt.Errorf("some erroor msg"))
+			err := integrationResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it updates an existing integration", func(t *testing.T) {
+			_, integrationResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateIntegrationReturns(&pt.Integration{ID: 5678}, nil, nil)
+			err := integrationResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, integrationID, integrationRequest := fakeClient.UpdateIntegrationArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(integrationID).To(Equal(5678))
+			Expect(integrationRequest.Type).To(BeEmpty(),
+				"it should not send the type, which can not change",
+			)
+			Expect(integrationRequest.APIPassword).To(Equal("s3cr3t"),
+				"it should send the credentials along with the other settings",
+			)
+		})
+	})
+}
+
+func createControlDataset() (pt.IntegrationRequest, *schema.Resource, *schema.ResourceData) {
+	integrationResource := integrations.NewIntegrationResource()
+	controlIntegration := pt.IntegrationRequest{
+		Type:        "jira",
+		Name:        "issues",
+		Active:      pt.Bool(true),
+		BaseURL:     "https://example.atlassian.net",
+		APIUsername: "tracker-bot",
+		APIPassword: "s3cr3t",
+		FilterID:    "10042",
+	}
+	schemaMap := map[string]interface{}{
+		"active":     true,
+		"name":       controlIntegration.Name,
+		"project_id": 1234,
+		"type":       controlIntegration.Type,
+		"jira": []interface{}{
+			map[string]interface{}{
+				"api_password": controlIntegration.APIPassword,
+				"api_username": controlIntegration.APIUsername,
+				"base_url":     controlIntegration.BaseURL,
+				"filter_id":    controlIntegration.FilterID,
+			},
+		},
+	}
+
+	fakeData := integrationResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlIntegration, integrationResource, fakeData
+}
@@ -0,0 +1,237 @@
+package iterationoverrides
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+)
+
+// defaultTeamStrength is the team strength of an iteration without an
+// override, the whole team at work.
+const defaultTeamStrength = 1.0
+
+func NewIterationOverrideResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createIterationOverride,
+		Read:          readIterationOverride,
+		Delete:        deleteIterationOverride,
+		Update:        updateIterationOverride,
+		Exists:        existsIterationOverride,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createIterationOverride(d *schema.ResourceData, meta interface{}) error {
+	projectID := d.Get("project_id").(int)
+	iterationNumber := d.Get("number").(int)
+	client := meta.(pt.ClientCaller)
+	_, _, err := client.UpdateIterationOverride(projectID, iterationNumber, getIterationOverrideRequest(d))
+	if err != nil {
+		return fmt.Errorf("creating iteration override failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, iterationNumber))
+	return readIterationOverride(d, meta)
+}
+
+func readIterationOverride(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, iterationNumber, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	overrides, _, err := client.ListIterationOverrides(projectID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("list iteration overrides api call failed: %w", err)
+	}
+
+	// tracker leaves an iteration out of the list once it is back to the
+	// project defaults, in which case the defaults are what is in place
+	override, err := findIterationOverride(client, projectID, iterationNumber, overrides)
+	if err != nil {
+		return err
+	}
+
+	d.Set("length", *override.Length)
+	d.Set("number", iterationNumber)
+	d.Set("project_id", projectID)
+	d.Set("team_strength", *override.TeamStrength)
+	d.SetId(ids.Format(projectID, iterationNumber))
+	return nil
+}
+
+func deleteIterationOverride(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, iterationNumber, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	overrideRequest, err := projectDefaults(client, projectID)
+	if pt.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.UpdateIterationOverride(projectID, iterationNumber, overrideRequest)
+	if err != nil {
+		return fmt.Errorf("reset iteration override failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateIterationOverride(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, iterationNumber, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.UpdateIterationOverride(projectID, iterationNumber, getIterationOverrideRequest(d))
+	if err != nil {
+		return fmt.Errorf("update iteration override failed: %w", err)
+	}
+
+	return readIterationOverride(d, meta)
+}
+
+func existsIterationOverride(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, iterationNumber, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	// a 404 means either the project is gone or it has no such iteration
+	_, _, err = client.GetIteration(projectID, iterationNumber)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get iteration api call failed: %w", err)
+	}
+
+	return true, nil
+}
+
+func parseID(id string) (int, int, error) {
+	values, err := ids.Parse(id, "project_id", "number")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+func getIterationOverrideRequest(d *schema.ResourceData) pt.IterationOverrideRequest {
+	overrideRequest := pt.IterationOverrideRequest{}
+	if v, ok := d.GetOk("length"); ok {
+		length := v.(int)
+		overrideRequest.Length = &length
+	}
+
+	teamStrength := d.Get("team_strength").(float64)
+	overrideRequest.TeamStrength = &teamStrength
+	return overrideRequest
+}
+
+// projectDefaults returns the length and team strength an iteration of the
+// given project has without an override.
+func projectDefaults(client pt.ClientCaller, projectID int) (pt.IterationOverrideRequest, error) {
+	project, _, err := client.GetProject(projectID)
+	if err != nil {
+		return pt.IterationOverrideRequest{}, fmt.Errorf("get project api call failed: %w", err)
+	}
+
+	length := project.IterationLength
+	teamStrength := defaultTeamStrength
+	return pt.IterationOverrideRequest{Length: &length, TeamStrength: &teamStrength}, nil
+}
+
+func findIterationOverride(client pt.ClientCaller, projectID int, iterationNumber int, overrides []pt.IterationOverride) (pt.IterationOverrideRequest, error) {
+	found := pt.IterationOverrideRequest{}
+	for _, override := range overrides {
+		if override.Number == iterationNumber {
+			found = override.IterationOverrideRequest
+			break
+		}
+	}
+
+	if found.Length != nil && found.TeamStrength != nil {
+		return found, nil
+	}
+
+	defaults, err := projectDefaults(client, projectID)
+	if err != nil {
+		return defaults, err
+	}
+
+	if found.Length != nil {
+		defaults.Length = found.Length
+	}
+	if found.TeamStrength != nil {
+		defaults.TeamStrength = found.TeamStrength
+	}
+	return defaults, nil
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project the iteration belongs to.`,
+		},
+
+		"number": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The number of the iteration, the first iteration of the
+				 project being 1.`,
+		},
+
+		"team_strength": &schema.Schema{
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Default:  defaultTeamStrength,
+			Description: `
+				float in the request body.
+				 —  The share of the team at work during the iteration, 1.0
+				 being the whole team. Tracker scales the expected velocity
+				 of the iteration by it.`,
+		},
+
+		"length": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+			Description: `
+				int in the request body.
+				 —  The length of the iteration in weeks. When not given, the
+				 iteration length of the project is used.`,
+		},
+	}
+}
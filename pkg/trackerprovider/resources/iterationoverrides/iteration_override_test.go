@@ -0,0 +1,268 @@
+package iterationoverrides_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/iterationoverrides"
+)
+
+func TestIterationOverride(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, overrideResource, _ := createControlDataset()
+			for k, v := range overrideResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlOverrideRequest, overrideResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateIterationOverrideReturns(nil, nil, fmt.Errorf("some erroor msg"))
+			err := overrideResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it overrides an iteration", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateIterationOverrideReturns(&pt.IterationOverride{Number: 12}, nil, nil)
+			fakeClient.ListIterationOverridesReturns([]pt.IterationOverride{
+				{Number: 12, IterationOverrideRequest: controlOverrideRequest},
+			}, nil, nil)
+			err := overrideResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/12"),
+				"it should set the <project_id>/<number> id of the overridden iteration",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, iterationNumber, overrideRequest := fakeClient.UpdateIterationOverrideArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(iterationNumber).To(Equal(12))
+				Expect(overrideRequest).To(Equal(controlOverrideRequest))
+			})
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, overrideResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/12")
+		t.Run("when the reset fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectReturns(&pt.Project{IterationLength: 2}, nil, nil)
+			fakeClient.UpdateIterationOverrideReturns(nil, nil, fmt.Errorf("some erroor msg"))
+			err := overrideResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when the project was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := overrideResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.UpdateIterationOverrideCallCount()).To(BeZero(),
+				"it should have nothing left to reset",
+			)
+		})
+
+		t.Run("when it resets an overridden iteration", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectReturns(&pt.Project{IterationLength: 2}, nil, nil)
+			err := overrideResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, iterationNumber, overrideRequest := fakeClient.UpdateIterationOverrideArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(iterationNumber).To(Equal(12))
+			Expect(*overrideRequest.Length).To(Equal(2),
+				"it should go back to the iteration length of the project",
+			)
+			Expect(*overrideRequest.TeamStrength).To(Equal(1.0),
+				"it should go back to the whole team",
+			)
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, overrideResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/12")
+		t.Run("when the project or the iteration was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetIterationReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := overrideResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+			projectID, iterationNumber := fakeClient.GetIterationArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(iterationNumber).To(Equal(12))
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetIterationReturns(nil, nil, fmt.Errorf("some erroor msg"))
+			_, err := overrideResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when the iteration exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetIterationReturns(&pt.Iteration{Number: 12, ProjectID: 1234}, nil, nil)
+			exists, err := overrideResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		t.Run("when the project was deleted outside terraform", func(t *testing.T) {
+			_, overrideResource, goneData := createControlDataset()
+			goneData.SetId("1234/12")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListIterationOverridesReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := overrideResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			_, overrideResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/12")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListIterationOverridesReturns(nil, nil, fmt.Errorf("some erroor msg"))
+			err := overrideResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an overridden iteration", func(t *testing.T) {
+			_, overrideResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/12")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			length := 3
+			teamStrength := 0.5
+			fakeClient.ListIterationOverridesReturns([]pt.IterationOverride{
+				{Number: 11},
+				{Number: 12, IterationOverrideRequest: pt.IterationOverrideRequest{Length: &length, TeamStrength: &teamStrength}},
+			}, nil, nil)
+			err := overrideResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("length")).To(Equal(3), "length")
+				Expect(fakeData.Get("number")).To(Equal(12), "number")
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+				Expect(fakeData.Get("team_strength")).To(Equal(0.5), "team_strength")
+			})
+		})
+
+		t.Run("when the iteration is back to the project defaults", func(t *testing.T) {
+			_, overrideResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/12")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListIterationOverridesReturns([]pt.IterationOverride{}, nil, nil)
+			fakeClient.GetProjectReturns(&pt.Project{IterationLength: 2}, nil, nil)
+			err := overrideResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/12"),
+				"it should keep the resource",
+			)
+			Expect(fakeData.Get("length")).To(Equal(2), "length")
+			Expect(fakeData.Get("team_strength")).To(Equal(1.0), "team_strength")
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Run("when update fails", func(t *testing.T) {
+			_, overrideResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/12")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateIterationOverrideReturns(nil, nil, fmt.Errorf("some erroor msg"))
+			err := overrideResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when only the team strength is given", func(t *testing.T) {
+			overrideResource := iterationoverrides.NewIterationOverrideResource()
+			fakeData := overrideResource.TestResourceData()
+			fakeData.SetId("1234/12")
+			fakeData.Set("team_strength", 0.8)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectReturns(&pt.Project{IterationLength: 1}, nil, nil)
+			err := overrideResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, _, overrideRequest := fakeClient.UpdateIterationOverrideArgsForCall(0)
+			Expect(overrideRequest.Length).To(BeNil(),
+				"it should leave the length alone",
+			)
+			Expect(*overrideRequest.TeamStrength).To(Equal(0.8))
+		})
+	})
+}
+
+func createControlDataset() (pt.IterationOverrideRequest, *schema.Resource, *schema.ResourceData) {
+	overrideResource := iterationoverrides.NewIterationOverrideResource()
+	length := 2
+	teamStrength := 0.6
+	controlOverride := pt.IterationOverrideRequest{
+		Length:       &length,
+		TeamStrength: &teamStrength,
+	}
+	schemaMap := map[string]interface{}{
+		"length":        length,
+		"number":        12,
+		"project_id":    1234,
+		"team_strength": teamStrength,
+	}
+
+	fakeData := overrideResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlOverride, overrideResource, fakeData
+}
@@ -0,0 +1,155 @@
+package labels
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+)
+
+func NewLabelResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createLabel,
+		Read:          readLabel,
+		Delete:        deleteLabel,
+		Update:        updateLabel,
+		Exists:        existsLabel,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createLabel(d *schema.ResourceData, meta interface{}) error {
+	labelRequest := pt.LabelRequest{}
+	labelRequest.Name = d.Get("name").(string)
+	projectID := d.Get("project_id").(int)
+	client := meta.(pt.ClientCaller)
+	labelResponse, _, err := client.NewLabel(projectID, labelRequest)
+	if err != nil {
+		return fmt.Errorf("creating new label failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, labelResponse.ID))
+	return nil
+}
+
+func readLabel(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, labelID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	labelResponse, _, err := client.GetLabel(projectID, labelID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get label api call failed: %w", err)
+	}
+
+	d.Set("name", labelResponse.Name)
+	d.Set("project_id", projectID)
+	d.SetId(ids.Format(projectID, labelResponse.ID))
+	return nil
+}
+
+func deleteLabel(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, labelID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteLabel(projectID, labelID)
+	if err != nil {
+		return fmt.Errorf("delete label failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateLabel(d *schema.ResourceData, meta interface{}) error {
+	labelRequest := pt.LabelRequest{}
+	labelRequest.Name = d.Get("name").(string)
+	client := meta.(pt.ClientCaller)
+	projectID, labelID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	labelResponse, _, err := client.UpdateLabel(projectID, labelID, labelRequest)
+	if err != nil {
+		return fmt.Errorf("update label failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, labelResponse.ID))
+	return nil
+}
+
+func existsLabel(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, labelID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	label, _, err := client.GetLabel(projectID, labelID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get label api call failed: %w", err)
+	}
+
+	if label.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, error) {
+	values, err := ids.Parse(id, "project_id", "label_id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+// SuppressNameCaseDiff keeps tracker's lower casing of label names from
+// showing up as a change.
+func SuppressNameCaseDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project the label belongs to.`,
+		},
+
+		"name": &schema.Schema{
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: SuppressNameCaseDiff,
+			Description: `
+				string[255] in the request body.
+				 —  The label's name. Label names are unique within a
+				 project and compared without regard to case.`,
+		},
+	}
+}
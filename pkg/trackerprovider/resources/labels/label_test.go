@@ -0,0 +1,223 @@
+package labels_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/labels"
+)
+
+func TestLabel(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, labelResource, _ := createControlDataset()
+			for k, v := range labelResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+
+		t.Run("Should ignore case only changes of the name", func(t *testing.T) {
+			_, labelResource, fakeData := createControlDataset()
+			suppress := labelResource.Schema["name"].DiffSuppressFunc
+			Expect(suppress("name", "tech-debt", "Tech-Debt", fakeData)).To(BeTrue())
+			Expect(suppress("name", "tech-debt", "security", fakeData)).To(BeFalse())
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlLabelRequest, labelResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewLabelReturns(&pt.Label{}, nil, fmt.Errorf("some erroor msg"))
+			err := labelResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it creates a new label", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewLabelReturns(&pt.Label{ID: 5678}, nil, nil)
+			err := labelResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should set the <project_id>/<label_id> id of the newly created resource",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, labelRequest := fakeClient.NewLabelArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(labelRequest).To(Equal(controlLabelRequest))
+			})
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, labelResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteLabelReturns(nil, fmt.Errorf("some erroor msg"))
+			err := labelResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing label", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := labelResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.DeleteLabelCallCount()).To(Equal(1),
+				"it should call delete exactly once",
+			)
+			projectID, labelID := fakeClient.DeleteLabelArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(labelID).To(Equal(5678))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, labelResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when label was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetLabelReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := labelResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetLabelReturns(&pt.Label{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := labelResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when label exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetLabelReturns(&pt.Label{ID: 5678}, nil, nil)
+			exists, err := labelResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, labelResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when label was deleted outside terraform", func(t *testing.T) {
+			goneData := labelResource.TestResourceData()
+			goneData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetLabelReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := labelResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetLabelReturns(&pt.Label{}, nil, fmt.Errorf("some erroor msg"))
+			err := labelResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing label", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlLabelResponse := &pt.Label{
+				ID:           5678,
+				LabelRequest: pt.LabelRequest{Name: "customer-reported"},
+			}
+			fakeClient.GetLabelReturns(controlLabelResponse, nil, nil)
+			err := labelResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("name")).To(Equal(controlLabelResponse.Name), "name")
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		_, labelResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when update fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateLabelReturns(&pt.Label{}, nil, fmt.Errorf("some erroor msg"))
+			err := labelResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it renames the label in place", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeData.Set("name", "security")
+			fakeClient.UpdateLabelReturns(&pt.Label{ID: 5678}, nil, nil)
+			err := labelResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, labelID, labelRequest := fakeClient.UpdateLabelArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(labelID).To(Equal(5678))
+			Expect(labelRequest.Name).To(Equal("security"))
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should keep the id of the resource",
+			)
+		})
+	})
+}
+
+func createControlDataset() (pt.LabelRequest, *schema.Resource, *schema.ResourceData) {
+	labelResource := labels.NewLabelResource()
+	controlLabel := pt.LabelRequest{
+		Name: "tech-debt",
+	}
+	schemaMap := map[string]interface{}{
+		"name":       controlLabel.Name,
+		"project_id": 1234,
+	}
+
+	fakeData := labelResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlLabel, labelResource, fakeData
+}
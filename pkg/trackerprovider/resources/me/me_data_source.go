@@ -0,0 +1,126 @@
+package me
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+func NewMeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read:   readMeDataSource,
+		Schema: createDataSourceSchema(),
+	}
+}
+
+func readMeDataSource(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	meResponse, _, err := client.GetMe()
+	if err != nil {
+		return fmt.Errorf("get me api call failed: %w", err)
+	}
+
+	accounts := make([]interface{}, 0, len(meResponse.Accounts))
+	for _, account := range meResponse.Accounts {
+		accounts = append(accounts, map[string]interface{}{
+			"id":     account.ID,
+			"name":   account.Name,
+			"plan":   account.Plan,
+			"status": account.Status,
+		})
+	}
+
+	memberships := make([]interface{}, 0, len(meResponse.Projects))
+	for _, membership := range meResponse.Projects {
+		memberships = append(memberships, map[string]interface{}{
+			"favorite":     membership.Favorite,
+			"id":           membership.ID,
+			"project_id":   membership.ProjectID,
+			"project_name": membership.ProjectName,
+			"role":         membership.Role,
+		})
+	}
+
+	d.Set("accounts", accounts)
+	d.Set("email", meResponse.Email)
+	d.Set("initials", meResponse.Initials)
+	d.Set("name", meResponse.Name)
+	d.Set("project_memberships", memberships)
+	d.Set("username", meResponse.Username)
+	d.SetId(strconv.Itoa(meResponse.ID))
+	return nil
+}
+
+func createDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				string[100] in the response body.
+				 —  The name of the person owning the access token. Their
+				 person id is the id of the data source.`,
+		},
+
+		"email": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				string[255] in the response body.
+				 —  The email address of the person.`,
+		},
+
+		"initials": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				string[255] in the response body.
+				 —  The initials of the person.`,
+		},
+
+		"username": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				string[100] in the response body.
+				 —  The username of the person.`,
+		},
+
+		"accounts": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":     &schema.Schema{Type: schema.TypeInt, Computed: true},
+					"name":   &schema.Schema{Type: schema.TypeString, Computed: true},
+					"plan":   &schema.Schema{Type: schema.TypeString, Computed: true},
+					"status": &schema.Schema{Type: schema.TypeString, Computed: true},
+				},
+			},
+			Description: `
+				List of account summaries in the response body.
+				 —  The accounts the person is a member of, each with id,
+				 name, plan and status.`,
+		},
+
+		"project_memberships": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":           &schema.Schema{Type: schema.TypeInt, Computed: true},
+					"project_id":   &schema.Schema{Type: schema.TypeInt, Computed: true},
+					"project_name": &schema.Schema{Type: schema.TypeString, Computed: true},
+					"role":         &schema.Schema{Type: schema.TypeString, Computed: true},
+					"favorite":     &schema.Schema{Type: schema.TypeBool, Computed: true},
+				},
+			},
+			Description: `
+				List of membership summaries in the response body.
+				 —  The projects the person is a member of, each with the
+				 membership id, project_id, project_name, role and favorite.`,
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package me_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/me"
+)
+
+func TestMeDataSource(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			meDataSource := me.NewMeDataSource()
+			for k, v := range meDataSource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		t.Run("when read fails", func(t *testing.T) {
+			meDataSource := me.NewMeDataSource()
+			fakeData := meDataSource.TestResourceData()
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetMeReturns(nil, nil, fmt.Errorf("some erroor msg"))
+			err := meDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads the token owner", func(t *testing.T) {
+			meDataSource := me.NewMeDataSource()
+			fakeData := meDataSource.TestResourceData()
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetMeReturns(&pt.Me{
+				Person: pt.Person{
+					ID:       101,
+					Name:     "Some One",
+					Email:    "someone@example.com",
+					Initials: "SO",
+					Username: "someone",
+				},
+				Accounts: []pt.AccountSummary{
+					{ID: 20, Name: "acme", Plan: "Startup", Status: "active"},
+				},
+				Projects: []pt.MembershipSummary{
+					{ID: 7, ProjectID: 1234, ProjectName: "platform", Role: "owner", Favorite: true},
+				},
+			}, nil, nil)
+			err := meDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the data source with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Id()).To(Equal("101"), "id")
+				Expect(fakeData.Get("email")).To(Equal("someone@example.com"), "email")
+				Expect(fakeData.Get("initials")).To(Equal("SO"), "initials")
+				Expect(fakeData.Get("name")).To(Equal("Some One"), "name")
+				Expect(fakeData.Get("username")).To(Equal("someone"), "username")
+				Expect(fakeData.Get("accounts")).To(Equal([]interface{}{
+					map[string]interface{}{"id": 20, "name": "acme", "plan": "Startup", "status": "active"},
+				}), "accounts")
+				Expect(fakeData.Get("project_memberships")).To(Equal([]interface{}{
+					map[string]interface{}{"id": 7, "project_id": 1234, "project_name": "platform", "role": "owner", "favorite": true},
+				}), "project_memberships")
+			})
+		})
+	})
+}
@@ -0,0 +1,194 @@
+package projectmemberships
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/validators"
+)
+
+func NewProjectMembershipResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createProjectMembership,
+		Read:          readProjectMembership,
+		Delete:        deleteProjectMembership,
+		Update:        updateProjectMembership,
+		Exists:        existsProjectMembership,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createProjectMembership(d *schema.ResourceData, meta interface{}) error {
+	membershipRequest := pt.ProjectMembershipRequest{}
+	membershipRequest.Email = d.Get("email").(string)
+	membershipRequest.PersonID = d.Get("person_id").(int)
+	membershipRequest.ProjectColor = d.Get("project_color").(string)
+	membershipRequest.Role = d.Get("role").(string)
+	if membershipRequest.Email == "" && membershipRequest.PersonID == 0 {
+		return fmt.Errorf("one of person_id or email is required")
+	}
+
+	projectID := d.Get("project_id").(int)
+	client := meta.(pt.ClientCaller)
+	membershipResponse, _, err := client.NewProjectMembership(projectID, membershipRequest)
+	if err != nil {
+		return fmt.Errorf("creating new project membership failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, membershipResponse.ID))
+	return nil
+}
+
+func readProjectMembership(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, membershipID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	membershipResponse, _, err := client.GetProjectMembership(projectID, membershipID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get project membership api call failed: %w", err)
+	}
+
+	d.Set("email", membershipResponse.Person.Email)
+	d.Set("person_id", membershipResponse.Person.ID)
+	d.Set("project_color", membershipResponse.ProjectColor)
+	d.Set("project_id", projectID)
+	d.Set("role", membershipResponse.Role)
+	d.SetId(ids.Format(projectID, membershipResponse.ID))
+	return nil
+}
+
+func deleteProjectMembership(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, membershipID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteProjectMembership(projectID, membershipID)
+	if err != nil {
+		return fmt.Errorf("delete project membership failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateProjectMembership(d *schema.ResourceData, meta interface{}) error {
+	membershipRequest := pt.ProjectMembershipRequest{}
+	membershipRequest.ProjectColor = d.Get("project_color").(string)
+	membershipRequest.Role = d.Get("role").(string)
+	client := meta.(pt.ClientCaller)
+	projectID, membershipID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	membershipResponse, _, err := client.UpdateProjectMembership(projectID, membershipID, membershipRequest)
+	if err != nil {
+		return fmt.Errorf("update project membership failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, membershipResponse.ID))
+	return nil
+}
+
+func existsProjectMembership(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, membershipID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	membership, _, err := client.GetProjectMembership(projectID, membershipID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get project membership api call failed: %w", err)
+	}
+
+	if membership.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, error) {
+	values, err := ids.Parse(id, "project_id", "membership_id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project the person should be a member of.`,
+		},
+
+		"person_id": &schema.Schema{
+			Type:          schema.TypeInt,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"email"},
+			Description: `
+				int in the request body.
+				 —  The ID of the person to add to the project. One of
+				 person_id or email is required.`,
+		},
+
+		"email": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"person_id"},
+			Description: `
+				string[255] in the request body.
+				 —  The email address of the person to add to the project. One
+				 of person_id or email is required.`,
+		},
+
+		"role": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validators.StringInSlice([]string{pt.ProjectOwner, pt.ProjectMemeber, pt.ProjectViewer}),
+			Description: `
+				enumerated string in the request body.
+				 —  The relationship between the project and the person.
+				 Valid enumeration values: owner, member, viewer`,
+		},
+
+		"project_color": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: `
+				string[6] in the request body.
+				 —  The hex color (ie. "8100ea") used to display the project
+				 in the person's dashboard.`,
+		},
+	}
+}
@@ -0,0 +1,254 @@
+package projectmemberships_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/projectmemberships"
+)
+
+func TestProjectMembership(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, membershipResource, _ := createControlDataset()
+			for k, v := range membershipResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+
+		t.Run("Should only allow tracker roles", func(t *testing.T) {
+			_, membershipResource, _ := createControlDataset()
+			validate := membershipResource.Schema["role"].ValidateFunc
+			for _, role := range []string{pt.ProjectOwner, pt.ProjectMemeber, pt.ProjectViewer} {
+				_, errs := validate(role, "role")
+				Expect(errs).To(BeEmpty(), role)
+			}
+			_, errs := validate("admin", "role")
+			Expect(errs).NotTo(BeEmpty(), "admin")
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlMembershipRequest, membershipResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewProjectMembershipReturns(&pt.ProjectMembershipResponse{}, nil, fmt.Errorf("some erroor msg"))
+			err := membershipResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when neither person_id nor email is set", func(t *testing.T) {
+			_, membershipResource, emptyData := createControlDataset()
+			emptyData.Set("email", "")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := membershipResource.Create(emptyData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeClient.NewProjectMembershipCallCount()).To(Equal(0),
+				"it should not call the tracker api",
+			)
+		})
+
+		t.Run("when it creates a new project membership", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewProjectMembershipReturns(&pt.ProjectMembershipResponse{ID: 5678}, nil, nil)
+			err := membershipResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should set the <project_id>/<membership_id> id of the newly created resource",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, membershipRequest := fakeClient.NewProjectMembershipArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(membershipRequest.Email).To(Equal(controlMembershipRequest.Email))
+				Expect(membershipRequest.PersonID).To(Equal(controlMembershipRequest.PersonID))
+				Expect(membershipRequest.ProjectColor).To(Equal(controlMembershipRequest.ProjectColor))
+				Expect(membershipRequest.Role).To(Equal(controlMembershipRequest.Role))
+			})
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, membershipResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteProjectMembershipReturns(nil, fmt.Errorf("some erroor msg"))
+			err := membershipResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing project membership", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := membershipResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.DeleteProjectMembershipCallCount()).To(Equal(1),
+				"it should call delete exactly once",
+			)
+			projectID, membershipID := fakeClient.DeleteProjectMembershipArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(membershipID).To(Equal(5678))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, membershipResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when project membership was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectMembershipReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := membershipResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectMembershipReturns(&pt.ProjectMembershipResponse{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := membershipResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when project membership exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectMembershipReturns(&pt.ProjectMembershipResponse{ID: 5678}, nil, nil)
+			exists, err := membershipResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, membershipResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when project membership was deleted outside terraform", func(t *testing.T) {
+			goneData := membershipResource.TestResourceData()
+			goneData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectMembershipReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := membershipResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectMembershipReturns(&pt.ProjectMembershipResponse{}, nil, fmt.Errorf("some erroor msg"))
+			err := membershipResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing project membership", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlMembershipResponse := &pt.ProjectMembershipResponse{
+				ID: 5678,
+				ProjectMembershipRequest: pt.ProjectMembershipRequest{
+					Role:         pt.ProjectViewer,
+					ProjectColor: "8100ea",
+				},
+				Person: pt.Person{ID: 42, Email: "someone@example.com"},
+			}
+			fakeClient.GetProjectMembershipReturns(controlMembershipResponse, nil, nil)
+			err := membershipResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("email")).To(Equal(controlMembershipResponse.Person.Email), "email")
+				Expect(fakeData.Get("person_id")).To(Equal(controlMembershipResponse.Person.ID), "person_id")
+				Expect(fakeData.Get("project_color")).To(Equal(controlMembershipResponse.ProjectColor), "project_color")
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+				Expect(fakeData.Get("role")).To(Equal(controlMembershipResponse.Role), "role")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		_, membershipResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when update fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateProjectMembershipReturns(&pt.ProjectMembershipResponse{}, nil, fmt.Errorf("some erroor msg"))
+			err := membershipResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it changes the role in place", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeData.Set("role", pt.ProjectOwner)
+			fakeClient.UpdateProjectMembershipReturns(&pt.ProjectMembershipResponse{ID: 5678}, nil, nil)
+			err := membershipResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, membershipID, membershipRequest := fakeClient.UpdateProjectMembershipArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(membershipID).To(Equal(5678))
+			Expect(membershipRequest.Role).To(Equal(pt.ProjectOwner))
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should keep the id of the resource",
+			)
+		})
+	})
+}
+
+func createControlDataset() (pt.ProjectMembershipRequest, *schema.Resource, *schema.ResourceData) {
+	membershipResource := projectmemberships.NewProjectMembershipResource()
+	controlMembership := pt.ProjectMembershipRequest{
+		Email:        "someone@example.com",
+		ProjectColor: "8100ea",
+		Role:         pt.ProjectMemeber,
+	}
+	schemaMap := map[string]interface{}{
+		"email":         controlMembership.Email,
+		"project_color": controlMembership.ProjectColor,
+		"project_id":    1234,
+		"role":          controlMembership.Role,
+	}
+
+	fakeData := membershipResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlMembership, membershipResource, fakeData
+}
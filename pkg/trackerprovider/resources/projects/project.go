@@ -3,11 +3,28 @@ package projects
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/salsita/go-pivotaltracker/v5/pivotal"
 	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/attrs"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/validators"
 )
 
+// dateLayout is the "YYYY-MM-DD" format tracker uses for dates.
+const dateLayout = "2006-01-02"
+
+var weekDays = []string{
+	string(pivotal.DaySunday),
+	string(pivotal.DayMonday),
+	string(pivotal.DayTuesday),
+	string(pivotal.DayWednesday),
+	string(pivotal.DayThursday),
+	string(pivotal.DayFriday),
+	string(pivotal.DaySaturday),
+}
+
 func NewProjectResource() *schema.Resource {
 	return &schema.Resource{
 		Create:        createProject,
@@ -25,13 +42,15 @@ func NewProjectResource() *schema.Resource {
 
 func createProject(d *schema.ResourceData, meta interface{}) error {
 	projectsRequest := pt.ProjectsRequest{}
+	projectsRequest.NoOwner = d.Get("no_owner").(bool)
+	projectsRequest.NewAccountName = d.Get("new_account_name").(string)
 	projectsRequest.AccountID = d.Get("account_id").(int)
-	projectsRequest.AtomEnabled = d.Get("atom_enabled").(bool)
-	projectsRequest.AutomaticPlanning = d.Get("automatic_planning").(bool)
-	projectsRequest.BugsAndChoresAreEstimatable = d.Get("bugs_and_chores_are_estimatable").(bool)
+	projectsRequest.AtomEnabled = attrs.ConfiguredBool(d, "atom_enabled")
+	projectsRequest.AutomaticPlanning = attrs.ConfiguredBool(d, "automatic_planning")
+	projectsRequest.BugsAndChoresAreEstimatable = attrs.ConfiguredBool(d, "bugs_and_chores_are_estimatable")
 	projectsRequest.Description = d.Get("description").(string)
-	projectsRequest.EnableIncomingEmails = d.Get("enable_incoming_emails").(bool)
-	projectsRequest.EnableTasks = d.Get("enable_tasks").(bool)
+	projectsRequest.EnableIncomingEmails = attrs.ConfiguredBool(d, "enable_incoming_emails")
+	projectsRequest.EnableTasks = attrs.ConfiguredBool(d, "enable_tasks")
 	projectsRequest.InitialVelocity = d.Get("initial_velocity").(int)
 	projectsRequest.IterationLength = d.Get("iteration_length").(int)
 	projectsRequest.JoinAs = d.Get("join_as").(string)
@@ -40,15 +59,24 @@ func createProject(d *schema.ResourceData, meta interface{}) error {
 	projectsRequest.PointScale = d.Get("point_scale").(string)
 	projectsRequest.ProfileContent = d.Get("profile_content").(string)
 	projectsRequest.ProjectType = d.Get("project_type").(string)
-	projectsRequest.Public = d.Get("public").(bool)
+	projectsRequest.Public = attrs.ConfiguredBool(d, "public")
 	projectsRequest.Status = d.Get("status").(string)
 	projectsRequest.VelocityAveragedOver = d.Get("velocity_averaged_over").(int)
+	projectsRequest.WeekStartDay = pivotal.Day(d.Get("week_start_day").(string))
+	projectsRequest.TimeZone = toTimeZone(d.Get("time_zone").(string))
+	startDate, err := toDate(d.Get("start_date").(string))
+	if err != nil {
+		return fmt.Errorf("conversion of start_date failed: %v", err)
+	}
+
+	projectsRequest.StartDate = startDate
 	client := meta.(pt.ClientCaller)
 	projectResponse, _, err := client.NewProject(projectsRequest)
 	if err != nil {
-		return fmt.Errorf("creating new project failed: %v", err)
+		return fmt.Errorf("creating new project failed: %w", err)
 	}
 
+	d.Set("account_id", projectResponse.AccountID)
 	d.SetId(strconv.Itoa(projectResponse.ID))
 	return nil
 }
@@ -61,26 +89,16 @@ func readProject(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	projectResponse, _, err := client.GetProject(id)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
-		return fmt.Errorf("get project api call failed: %v", err)
+		return fmt.Errorf("get project api call failed: %w", err)
 	}
 
-	d.Set("account_id", projectResponse.AccountID)
-	d.Set("atom_enabled", projectResponse.AtomEnabled)
-	d.Set("automatic_planning", projectResponse.AutomaticPlanning)
-	d.Set("bugs_and_chores_are_estimatable", projectResponse.BugsAndChoresAreEstimatable)
-	d.Set("description", projectResponse.Description)
-	d.Set("enable_incoming_emails", projectResponse.EnableIncomingEmails)
-	d.Set("enable_tasks", projectResponse.EnableTasks)
-	d.Set("initial_velocity", projectResponse.InitialVelocity)
-	d.Set("iteration_length", projectResponse.IterationLength)
-	d.Set("name", projectResponse.Name)
-	d.Set("number_of_done_iterations_to_show", projectResponse.NumberOfDoneIterationsToShow)
-	d.Set("point_scale", projectResponse.PointScale)
-	d.Set("profile_content", projectResponse.ProfileContent)
-	d.Set("project_type", projectResponse.ProjectType)
-	d.Set("public", projectResponse.Public)
-	d.Set("velocity_averaged_over", projectResponse.VelocityAveragedOver)
+	setProject(d, projectResponse)
 	d.SetId(strconv.Itoa(projectResponse.ID))
 	return nil
 }
@@ -94,7 +112,7 @@ func deleteProject(d *schema.ResourceData, meta interface{}) error {
 
 	_, err = client.DeleteProject(id)
 	if err != nil {
-		return fmt.Errorf("delete project failed: %v", err)
+		return fmt.Errorf("delete project failed: %w", err)
 	}
 
 	return nil
@@ -102,24 +120,79 @@ func deleteProject(d *schema.ResourceData, meta interface{}) error {
 
 func updateProject(d *schema.ResourceData, meta interface{}) error {
 	projectRequest := pt.ProjectRequest{}
-	projectRequest.AccountID = d.Get("account_id").(int)
-	projectRequest.AtomEnabled = d.Get("atom_enabled").(bool)
-	projectRequest.AutomaticPlanning = d.Get("automatic_planning").(bool)
-	projectRequest.BugsAndChoresAreEstimatable = d.Get("bugs_and_chores_are_estimatable").(bool)
-	projectRequest.Description = d.Get("description").(string)
-	projectRequest.EnableIncomingEmails = d.Get("enable_incoming_emails").(bool)
-	projectRequest.EnableTasks = d.Get("enable_tasks").(bool)
-	projectRequest.InitialVelocity = d.Get("initial_velocity").(int)
-	projectRequest.IterationLength = d.Get("iteration_length").(int)
-	projectRequest.JoinAs = d.Get("join_as").(string)
-	projectRequest.Name = d.Get("name").(string)
-	projectRequest.NumberOfDoneIterationsToShow = d.Get("number_of_done_iterations_to_show").(int)
-	projectRequest.PointScale = d.Get("point_scale").(string)
-	projectRequest.ProfileContent = d.Get("profile_content").(string)
-	projectRequest.ProjectType = d.Get("project_type").(string)
-	projectRequest.Public = d.Get("public").(bool)
-	projectRequest.Status = d.Get("status").(string)
-	projectRequest.VelocityAveragedOver = d.Get("velocity_averaged_over").(int)
+	if d.HasChange("account_id") {
+		projectRequest.AccountID = d.Get("account_id").(int)
+	}
+
+	projectRequest.AtomEnabled = attrs.ChangedBool(d, "atom_enabled")
+	projectRequest.AutomaticPlanning = attrs.ChangedBool(d, "automatic_planning")
+	projectRequest.BugsAndChoresAreEstimatable = attrs.ChangedBool(d, "bugs_and_chores_are_estimatable")
+	if d.HasChange("description") {
+		projectRequest.Description = d.Get("description").(string)
+		projectRequest.ClearDescription = projectRequest.Description == ""
+	}
+
+	projectRequest.EnableIncomingEmails = attrs.ChangedBool(d, "enable_incoming_emails")
+	projectRequest.EnableTasks = attrs.ChangedBool(d, "enable_tasks")
+	if d.HasChange("initial_velocity") {
+		projectRequest.InitialVelocity = d.Get("initial_velocity").(int)
+	}
+
+	if d.HasChange("iteration_length") {
+		projectRequest.IterationLength = d.Get("iteration_length").(int)
+	}
+
+	if d.HasChange("join_as") {
+		projectRequest.JoinAs = d.Get("join_as").(string)
+	}
+
+	if d.HasChange("name") {
+		projectRequest.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("number_of_done_iterations_to_show") {
+		projectRequest.NumberOfDoneIterationsToShow = d.Get("number_of_done_iterations_to_show").(int)
+	}
+
+	if d.HasChange("point_scale") {
+		projectRequest.PointScale = d.Get("point_scale").(string)
+	}
+
+	if d.HasChange("profile_content") {
+		projectRequest.ProfileContent = d.Get("profile_content").(string)
+		projectRequest.ClearProfileContent = projectRequest.ProfileContent == ""
+	}
+
+	if d.HasChange("project_type") {
+		projectRequest.ProjectType = d.Get("project_type").(string)
+	}
+
+	projectRequest.Public = attrs.ChangedBool(d, "public")
+	if d.HasChange("status") {
+		projectRequest.Status = d.Get("status").(string)
+	}
+
+	if d.HasChange("velocity_averaged_over") {
+		projectRequest.VelocityAveragedOver = d.Get("velocity_averaged_over").(int)
+	}
+
+	if d.HasChange("week_start_day") {
+		projectRequest.WeekStartDay = pivotal.Day(d.Get("week_start_day").(string))
+	}
+
+	if d.HasChange("time_zone") {
+		projectRequest.TimeZone = toTimeZone(d.Get("time_zone").(string))
+	}
+
+	if d.HasChange("start_date") {
+		startDate, err := toDate(d.Get("start_date").(string))
+		if err != nil {
+			return fmt.Errorf("conversion of start_date failed: %v", err)
+		}
+
+		projectRequest.StartDate = startDate
+	}
+
 	client := meta.(pt.ClientCaller)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -128,7 +201,7 @@ func updateProject(d *schema.ResourceData, meta interface{}) error {
 
 	projectResponse, _, err := client.UpdateProject(id, projectRequest)
 	if err != nil {
-		return fmt.Errorf("update project failed: %v", err)
+		return fmt.Errorf("update project failed: %w", err)
 	}
 
 	d.SetId(strconv.Itoa(projectResponse.ID))
@@ -143,8 +216,12 @@ func existsProject(d *schema.ResourceData, meta interface{}) (bool, error) {
 	}
 
 	project, _, err := client.GetProject(id)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
 	if err != nil {
-		return false, fmt.Errorf("get project api call failed: %v", err)
+		return false, fmt.Errorf("get project api call failed: %w", err)
 	}
 
 	if project.ID > 0 {
@@ -153,11 +230,90 @@ func existsProject(d *schema.ResourceData, meta interface{}) (bool, error) {
 	return false, nil
 }
 
+// setProject sets the attributes read back from the api, shared by the
+// resource and the data source.
+func setProject(d *schema.ResourceData, projectResponse *pt.Project) {
+	d.Set("account_id", projectResponse.AccountID)
+	d.Set("atom_enabled", projectResponse.AtomEnabled)
+	d.Set("automatic_planning", projectResponse.AutomaticPlanning)
+	d.Set("bugs_and_chores_are_estimatable", projectResponse.BugsAndChoresAreEstimatable)
+	d.Set("description", projectResponse.Description)
+	d.Set("enable_incoming_emails", projectResponse.EnableIncomingEmails)
+	d.Set("enable_tasks", projectResponse.EnableTasks)
+	d.Set("initial_velocity", projectResponse.InitialVelocity)
+	d.Set("iteration_length", projectResponse.IterationLength)
+	d.Set("name", projectResponse.Name)
+	d.Set("number_of_done_iterations_to_show", projectResponse.NumberOfDoneIterationsToShow)
+	d.Set("point_scale", projectResponse.PointScale)
+	d.Set("profile_content", projectResponse.ProfileContent)
+	d.Set("project_type", projectResponse.ProjectType)
+	d.Set("public", projectResponse.Public)
+	d.Set("velocity_averaged_over", projectResponse.VelocityAveragedOver)
+	d.Set("start_date", fromDate(projectResponse.StartDate))
+	d.Set("time_zone", fromTimeZone(projectResponse.TimeZone))
+	d.Set("week_start_day", string(projectResponse.WeekStartDay))
+}
+
+// toDate converts a "YYYY-MM-DD" string into a tracker date, an empty
+// string is left unset.
+func toDate(value string) (*pivotal.Date, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return nil, err
+	}
+
+	date := pivotal.Date(t)
+	return &date, nil
+}
+
+func fromDate(date *pivotal.Date) string {
+	if date == nil || time.Time(*date).IsZero() {
+		return ""
+	}
+
+	return time.Time(*date).Format(dateLayout)
+}
+
+// toTimeZone builds a tracker time zone from an IANA zone name, which is
+// what tracker calls the olson_name.
+func toTimeZone(olsonName string) *pivotal.TimeZone {
+	if olsonName == "" {
+		return nil
+	}
+
+	return &pivotal.TimeZone{OlsonName: olsonName}
+}
+
+func fromTimeZone(timeZone *pivotal.TimeZone) string {
+	if timeZone == nil {
+		return ""
+	}
+
+	return timeZone.OlsonName
+}
+
+func validateDate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := time.Parse(dateLayout, v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a YYYY-MM-DD date, got %s", k, v)}
+	}
+	return nil, nil
+}
+
 func createSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"no_owner": &schema.Schema{
 			Type:     schema.TypeBool,
 			Optional: true,
+			ForceNew: true,
 			Description: `
 				boolean in the request body.
 				 —  By default, the user whose credentials are supplied 
@@ -166,11 +322,14 @@ func createSchema() map[string]*schema.Schema {
 		},
 
 		"new_account_name": &schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"account_id"},
 			Description: `string[100] in the request body.
 				 —  If specified, creates a new account with the specified 
-				 name, and adds the new project to that account.`,
+				 name, and adds the new project to that account. The ID of
+				 the new account is exported as account_id.`,
 		},
 
 		"name": &schema.Schema{
@@ -198,8 +357,10 @@ func createSchema() map[string]*schema.Schema {
 		},
 
 		"week_start_day": &schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validators.StringInSlice(weekDays),
 			Description: `
 				enumerated string in the request body.
 				 —  The day in the week the project's iterations are
@@ -258,8 +419,9 @@ func createSchema() map[string]*schema.Schema {
 		},
 
 		"start_date": &schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDate,
 			Description: `
 				date in the request body.
 				 —  The first day that should be in an iteration of the 
@@ -277,10 +439,12 @@ func createSchema() map[string]*schema.Schema {
 		"time_zone": &schema.Schema{
 			Type:     schema.TypeString,
 			Optional: true,
+			Computed: true,
 			Description: `
 				time_zone in the request body.  —  The "native" time zone for the
 				project, independent of the time zone(s) from which members of the
-				project view or modify it.`,
+				project view or modify it. Specified as an IANA zone name (the
+				tracker olson_name), ie. "America/New_York".`,
 		},
 
 		"velocity_averaged_over": &schema.Schema{
@@ -0,0 +1,185 @@
+package projects
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+// projectAttributes are the attributes of the resource which are read back
+// from the api, and so are exported by the data source as well.
+var projectAttributes = []string{
+	"atom_enabled",
+	"automatic_planning",
+	"bugs_and_chores_are_estimatable",
+	"description",
+	"enable_incoming_emails",
+	"enable_tasks",
+	"initial_velocity",
+	"iteration_length",
+	"number_of_done_iterations_to_show",
+	"point_scale",
+	"profile_content",
+	"project_type",
+	"public",
+	"start_date",
+	"time_zone",
+	"velocity_averaged_over",
+	"week_start_day",
+}
+
+func NewProjectDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read:   readProjectDataSource,
+		Schema: createDataSourceSchema(),
+	}
+}
+
+func readProjectDataSource(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	var projectResponse *pt.Project
+	if v, ok := d.GetOk("id"); ok {
+		id, err := strconv.Atoi(v.(string))
+		if err != nil {
+			return fmt.Errorf("conversion of id failed: %v", err)
+		}
+
+		projectResponse, _, err = client.GetProject(id)
+		if err != nil {
+			return fmt.Errorf("get project api call failed: %w", err)
+		}
+	} else {
+		name := d.Get("name").(string)
+		if name == "" {
+			return fmt.Errorf("one of id or name must be set to look up a project")
+		}
+
+		var err error
+		projectResponse, err = findProjectByName(client, name, d.Get("account_id").(int))
+		if err != nil {
+			return err
+		}
+	}
+
+	setProject(d, projectResponse)
+	d.Set("created_at", fromTime(projectResponse.CreatedAt))
+	d.Set("current_iteration_number", projectResponse.CurrentIterationNumber)
+	d.Set("updated_at", fromTime(projectResponse.UpdatedAt))
+	d.SetId(strconv.Itoa(projectResponse.ID))
+	return nil
+}
+
+// findProjectByName returns the only project with the given name, within the
+// given account when accountID is not 0.
+func findProjectByName(client pt.ClientCaller, name string, accountID int) (*pt.Project, error) {
+	projects, _, err := client.ListProjects()
+	if err != nil {
+		return nil, fmt.Errorf("list projects api call failed: %w", err)
+	}
+
+	var matches []*pt.Project
+	for _, project := range projects {
+		if project.Name != name {
+			continue
+		}
+
+		if accountID != 0 && project.AccountID != accountID {
+			continue
+		}
+		matches = append(matches, project)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no project named %q was found", name)
+	case 1:
+		return matches[0], nil
+	}
+
+	matchIDs := make([]string, len(matches))
+	for i, project := range matches {
+		matchIDs[i] = strconv.Itoa(project.ID)
+	}
+	return nil, fmt.Errorf("%d projects are named %q (ids %v), set account_id or id to pick one",
+		len(matches), name, strings.Join(matchIDs, ", "))
+}
+
+func fromTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func createDataSourceSchema() map[string]*schema.Schema {
+	dataSourceSchema := map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"name"},
+			Description: `
+				int in the request path.
+				 —  The ID of the project to look up.`,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: `
+				string[50] in the response body.
+				 —  The name of the project to look up, when id is not given.
+				 Exactly one project must have this name.`,
+		},
+
+		"account_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+			Description: `
+				int in the response body.
+				 —  The ID of the account the project belongs to. Narrows the
+				 lookup by name to that account.`,
+		},
+
+		"current_iteration_number": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+			Description: `
+				int in the response body.
+				 —  The number of the current iteration of the project.`,
+		},
+
+		"created_at": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				datetime in the response body.
+				 —  Creation time of the project, as an RFC 3339 timestamp.`,
+		},
+
+		"updated_at": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				datetime in the response body.
+				 —  Time of the last update of the project, as an RFC 3339
+				 timestamp.`,
+		},
+	}
+
+	resourceSchema := createSchema()
+	for _, k := range projectAttributes {
+		dataSourceSchema[k] = &schema.Schema{
+			Type:        resourceSchema[k].Type,
+			Computed:    true,
+			Description: resourceSchema[k].Description,
+		}
+	}
+	return dataSourceSchema
+}
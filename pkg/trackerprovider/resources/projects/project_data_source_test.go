@@ -0,0 +1,132 @@
+package projects_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/projects"
+)
+
+func TestProjectDataSource(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			projectDataSource := projects.NewProjectDataSource()
+			for k, v := range projectDataSource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+
+		t.Run("Should export every attribute the resource reads", func(t *testing.T) {
+			projectDataSource := projects.NewProjectDataSource()
+			for k := range projects.NewProjectResource().Schema {
+				switch k {
+				case "join_as", "new_account_name", "no_owner", "status":
+					continue
+				}
+				Expect(projectDataSource.Schema).To(HaveKey(k))
+			}
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		createdAt := time.Date(2019, 7, 1, 9, 30, 0, 0, time.UTC)
+		controlProjects := []*pt.Project{
+			{ID: 1, Name: "platform", AccountID: 10},
+			{ID: 2, Name: "platform", AccountID: 20, CurrentIterationNumber: 42, CreatedAt: &createdAt, IterationLength: 2},
+			{ID: 3, Name: "web", AccountID: 20},
+		}
+
+		t.Run("when it looks up a project by id", func(t *testing.T) {
+			projectDataSource := projects.NewProjectDataSource()
+			fakeData := projectDataSource.TestResourceData()
+			fakeData.Set("id", "2")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectReturns(controlProjects[1], nil, nil)
+			err := projectDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.GetProjectArgsForCall(0)).To(Equal(2))
+			Expect(fakeData.Id()).To(Equal("2"))
+			Expect(fakeData.Get("account_id")).To(Equal(20), "account_id")
+			Expect(fakeData.Get("created_at")).To(Equal("2019-07-01T09:30:00Z"), "created_at")
+			Expect(fakeData.Get("current_iteration_number")).To(Equal(42), "current_iteration_number")
+			Expect(fakeData.Get("iteration_length")).To(Equal(2), "iteration_length")
+			Expect(fakeData.Get("name")).To(Equal("platform"), "name")
+		})
+
+		t.Run("when the id is not found", func(t *testing.T) {
+			projectDataSource := projects.NewProjectDataSource()
+			fakeData := projectDataSource.TestResourceData()
+			fakeData.Set("id", "2")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := projectDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it looks up a project by name and account", func(t *testing.T) {
+			projectDataSource := projects.NewProjectDataSource()
+			fakeData := projectDataSource.TestResourceData()
+			fakeData.Set("name", "platform")
+			fakeData.Set("account_id", 20)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListProjectsReturns(controlProjects, nil, nil)
+			err := projectDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("2"))
+		})
+
+		t.Run("when several projects have the name", func(t *testing.T) {
+			projectDataSource := projects.NewProjectDataSource()
+			fakeData := projectDataSource.TestResourceData()
+			fakeData.Set("name", "platform")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListProjectsReturns(controlProjects, nil, nil)
+			err := projectDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(MatchError(ContainSubstring("2 projects are named \"platform\" (ids 1, 2)")),
+				"it should name the matching projects",
+			)
+		})
+
+		t.Run("when no project has the name", func(t *testing.T) {
+			projectDataSource := projects.NewProjectDataSource()
+			fakeData := projectDataSource.TestResourceData()
+			fakeData.Set("name", "mobile")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListProjectsReturns(controlProjects, nil, nil)
+			err := projectDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when neither id nor name is given", func(t *testing.T) {
+			projectDataSource := projects.NewProjectDataSource()
+			fakeData := projectDataSource.TestResourceData()
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := projectDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeClient.Invocations()).To(BeEmpty(),
+				"it should not call the tracker API",
+			)
+		})
+	})
+}
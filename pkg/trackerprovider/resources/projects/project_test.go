@@ -1,11 +1,15 @@
 package projects_test
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 	. "github.com/onsi/gomega"
 	"github.com/salsita/go-pivotaltracker/v5/pivotal"
 	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
@@ -35,6 +39,13 @@ func TestProject(t *testing.T) {
 				)
 			}
 		})
+
+		t.Run("Should replace the project when a create-only field changes", func(t *testing.T) {
+			projectResource := projects.NewProjectResource()
+			for _, k := range []string{"new_account_name", "no_owner"} {
+				Expect(projectResource.Schema[k].ForceNew).To(BeTrue(), k)
+			}
+		})
 	})
 
 	t.Run("Create", func(t *testing.T) {
@@ -48,11 +59,35 @@ func TestProject(t *testing.T) {
 			)
 		})
 
+		t.Run("when tracker rejects the project with validation errors", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewProjectReturns(nil, nil, &pt.Error{
+				StatusCode: http.StatusBadRequest,
+				Code:       "invalid_parameter",
+				ValidationErrors: []pt.ValidationError{
+					{Field: "name", Problem: "already used by another project in this account"},
+				},
+			})
+			err := projectResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(err.Error()).To(ContainSubstring("name: already used by another project in this account"),
+				"it should surface the field level problem",
+			)
+			var apiErr *pt.Error
+			Expect(errors.As(err, &apiErr)).To(BeTrue(),
+				"it should keep the tracker api error in the chain",
+			)
+		})
+
 		t.Run("when it creates a new project", func(t *testing.T) {
 			fakeClient := &ptfakes.FakeClientCaller{}
 			controlResourceID := 1234
+			controlAccountID := 98765
 			fakeClient.NewProjectReturns(&pt.Project{
-				ID: controlResourceID,
+				ID:        controlResourceID,
+				AccountID: controlAccountID,
 			}, nil, nil)
 			err := projectResource.Create(fakeData, fakeClient)
 			projectRequest := fakeClient.NewProjectArgsForCall(0)
@@ -63,8 +98,13 @@ func TestProject(t *testing.T) {
 			Expect(fakeData.Id()).To(Equal(strconv.Itoa(controlResourceID)),
 				"it should set the id of the newly created resource",
 			)
+			Expect(fakeData.Get("account_id")).To(Equal(controlAccountID),
+				"it should set the account id the project was created in",
+			)
 
 			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				Expect(projectRequest.NoOwner).To(Equal(controlProjectRequest.NoOwner))
+				Expect(projectRequest.NewAccountName).To(Equal(controlProjectRequest.NewAccountName))
 				Expect(projectRequest.AccountID).To(Equal(controlProjectRequest.AccountID))
 				Expect(projectRequest.AtomEnabled).To(Equal(controlProjectRequest.AtomEnabled))
 				Expect(projectRequest.AutomaticPlanning).To(Equal(controlProjectRequest.AutomaticPlanning))
@@ -83,8 +123,24 @@ func TestProject(t *testing.T) {
 				Expect(projectRequest.Public).To(Equal(controlProjectRequest.Public))
 				Expect(projectRequest.Status).To(Equal(controlProjectRequest.Status))
 				Expect(projectRequest.VelocityAveragedOver).To(Equal(controlProjectRequest.VelocityAveragedOver))
+				Expect(projectRequest.WeekStartDay).To(Equal(controlProjectRequest.WeekStartDay))
+				Expect(projectRequest.TimeZone).To(Equal(controlProjectRequest.TimeZone))
+				Expect(projectRequest.StartDate).To(Equal(controlProjectRequest.StartDate))
 			})
 		})
+
+		t.Run("when start_date is not a YYYY-MM-DD date", func(t *testing.T) {
+			_, _, projectResource, badData := createControlDataset()
+			badData.Set("start_date", "01/15/2019")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := projectResource.Create(badData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeClient.NewProjectCallCount()).To(Equal(0),
+				"it should not call the tracker api",
+			)
+		})
 	})
 
 	t.Run("Delete", func(t *testing.T) {
@@ -117,6 +173,18 @@ func TestProject(t *testing.T) {
 	t.Run("Exists", func(t *testing.T) {
 		_, _, projectResource, fakeData := createControlDataset()
 		fakeData.SetId("1234")
+		t.Run("when project was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectReturns(nil, nil, &pt.Error{StatusCode: http.StatusNotFound})
+			exists, err := projectResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
 		t.Run("when exists call fails", func(t *testing.T) {
 			fakeClient := &ptfakes.FakeClientCaller{}
 			fakeClient.GetProjectReturns(&pt.Project{}, nil, fmt.Errorf("some erroor msg"))
@@ -154,6 +222,20 @@ func TestProject(t *testing.T) {
 	t.Run("Read", func(t *testing.T) {
 		_, _, projectResource, fakeData := createControlDataset()
 		fakeData.SetId("1234")
+		t.Run("when project was deleted outside terraform", func(t *testing.T) {
+			goneData := projectResource.TestResourceData()
+			goneData.SetId("1234")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetProjectReturns(nil, nil, &pt.Error{StatusCode: http.StatusNotFound})
+			err := projectResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
 		t.Run("when read fails", func(t *testing.T) {
 			fakeClient := &ptfakes.FakeClientCaller{}
 			fakeClient.GetProjectReturns(&pt.Project{}, nil, fmt.Errorf("some erroor msg"))
@@ -165,7 +247,16 @@ func TestProject(t *testing.T) {
 
 		t.Run("when it reads an existing project", func(t *testing.T) {
 			fakeClient := &ptfakes.FakeClientCaller{}
-			controlProjectResponse := &pt.Project{ID: 1234, AccountID: 12345, AtomEnabled: true, Description: "blah"}
+			controlStartDate := pivotal.Date(time.Date(2019, time.March, 4, 0, 0, 0, 0, time.UTC))
+			controlProjectResponse := &pt.Project{
+				ID:           1234,
+				AccountID:    12345,
+				AtomEnabled:  true,
+				Description:  "blah",
+				StartDate:    &controlStartDate,
+				TimeZone:     &pivotal.TimeZone{Kind: "time_zone", OlsonName: "America/Los_Angeles", Offset: "-08:00"},
+				WeekStartDay: pivotal.DayMonday,
+			}
 			fakeClient.GetProjectReturns(controlProjectResponse, nil, nil)
 			err := projectResource.Read(fakeData, fakeClient)
 			Expect(err).NotTo(HaveOccurred(),
@@ -195,6 +286,9 @@ func TestProject(t *testing.T) {
 				Expect(fakeData.Get("project_type")).To(Equal(controlProjectResponse.ProjectType), "project_type")
 				Expect(fakeData.Get("public")).To(Equal(controlProjectResponse.Public), "public")
 				Expect(fakeData.Get("velocity_averaged_over")).To(Equal(controlProjectResponse.VelocityAveragedOver), "velocity_averaged_over")
+				Expect(fakeData.Get("start_date")).To(Equal("2019-03-04"), "start_date")
+				Expect(fakeData.Get("time_zone")).To(Equal("America/Los_Angeles"), "time_zone")
+				Expect(fakeData.Get("week_start_day")).To(Equal("Monday"), "week_start_day")
 			})
 		})
 	})
@@ -202,6 +296,77 @@ func TestProject(t *testing.T) {
 	t.Run("Update", func(t *testing.T) {
 		_, _, projectResource, fakeData := createControlDataset()
 		fakeData.SetId("1234")
+		t.Run("when a bool attribute is turned off", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			existingData := projectResource.Data(&terraform.InstanceState{
+				ID: "1234",
+				Attributes: map[string]string{
+					"name":         "someprojects",
+					"public":       "true",
+					"atom_enabled": "true",
+				},
+			})
+			existingData.Set("public", false)
+			fakeClient.UpdateProjectReturns(&pt.Project{ID: 1234}, nil, nil)
+			err := projectResource.Update(existingData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, updatedProject := fakeClient.UpdateProjectArgsForCall(0)
+			Expect(updatedProject.Public).To(Equal(pt.Bool(false)),
+				"it should send an explicit false for the changed attribute",
+			)
+			Expect(updatedProject.AtomEnabled).To(BeNil(),
+				"it should leave unchanged attributes out of the request",
+			)
+		})
+
+		t.Run("when only the description changes", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			existingData := projectResource.Data(&terraform.InstanceState{
+				ID: "1234",
+				Attributes: map[string]string{
+					"name":             "someprojects",
+					"description":      "blah",
+					"iteration_length": "2",
+					"week_start_day":   "Monday",
+				},
+			})
+			existingData.Set("description", "blah blah")
+			fakeClient.UpdateProjectReturns(&pt.Project{ID: 1234}, nil, nil)
+			err := projectResource.Update(existingData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, updatedProject := fakeClient.UpdateProjectArgsForCall(0)
+			Expect(updatedProject).To(Equal(pt.ProjectRequest{Description: "blah blah"}),
+				"it should leave unchanged strings and ints out of the request",
+			)
+		})
+
+		t.Run("when the description and the profile content are removed", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			existingData := projectResource.Data(&terraform.InstanceState{
+				ID: "1234",
+				Attributes: map[string]string{
+					"name":            "someprojects",
+					"description":     "blah",
+					"profile_content": "blah blah",
+				},
+			})
+			existingData.Set("description", "")
+			existingData.Set("profile_content", "")
+			fakeClient.UpdateProjectReturns(&pt.Project{ID: 1234}, nil, nil)
+			err := projectResource.Update(existingData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, updatedProject := fakeClient.UpdateProjectArgsForCall(0)
+			Expect(updatedProject).To(Equal(pt.ProjectRequest{ClearDescription: true, ClearProfileContent: true}),
+				"it should send the emptied strings",
+			)
+		})
+
 		t.Run("when read fails", func(t *testing.T) {
 			fakeClient := &ptfakes.FakeClientCaller{}
 			fakeClient.UpdateProjectReturns(&pt.Project{}, nil, fmt.Errorf("some erroor msg"))
@@ -231,12 +396,12 @@ func TestProject(t *testing.T) {
 			)
 			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
 				Expect(fakeData.Get("account_id")).To(Equal(updatedProject.AccountID), "account_id")
-				Expect(fakeData.Get("atom_enabled")).To(Equal(updatedProject.AtomEnabled), "atom_enabled")
-				Expect(fakeData.Get("automatic_planning")).To(Equal(updatedProject.AutomaticPlanning), "automatic_planning")
-				Expect(fakeData.Get("bugs_and_chores_are_estimatable")).To(Equal(updatedProject.BugsAndChoresAreEstimatable), "bugs_and_chores_are_estimateable")
+				Expect(fakeData.Get("atom_enabled")).To(Equal(pt.BoolValue(updatedProject.AtomEnabled)), "atom_enabled")
+				Expect(fakeData.Get("automatic_planning")).To(Equal(pt.BoolValue(updatedProject.AutomaticPlanning)), "automatic_planning")
+				Expect(fakeData.Get("bugs_and_chores_are_estimatable")).To(Equal(pt.BoolValue(updatedProject.BugsAndChoresAreEstimatable)), "bugs_and_chores_are_estimateable")
 				Expect(fakeData.Get("description")).To(Equal(updatedProject.Description), "description")
-				Expect(fakeData.Get("enable_incoming_emails")).To(Equal(updatedProject.EnableIncomingEmails), "enable_incoming_emails")
-				Expect(fakeData.Get("enable_tasks")).To(Equal(updatedProject.EnableTasks), "enable_tasks")
+				Expect(fakeData.Get("enable_incoming_emails")).To(Equal(pt.BoolValue(updatedProject.EnableIncomingEmails)), "enable_incoming_emails")
+				Expect(fakeData.Get("enable_tasks")).To(Equal(pt.BoolValue(updatedProject.EnableTasks)), "enable_tasks")
 				Expect(fakeData.Get("initial_velocity")).To(Equal(updatedProject.InitialVelocity), "initial_velocity")
 				Expect(fakeData.Get("iteration_length")).To(Equal(updatedProject.IterationLength), "iteration_length")
 				Expect(fakeData.Get("name")).To(Equal(updatedProject.Name), "name")
@@ -244,8 +409,11 @@ func TestProject(t *testing.T) {
 				Expect(fakeData.Get("point_scale")).To(Equal(updatedProject.PointScale), "point_scale")
 				Expect(fakeData.Get("profile_content")).To(Equal(updatedProject.ProfileContent), "profile_content")
 				Expect(fakeData.Get("project_type")).To(Equal(updatedProject.ProjectType), "project_type")
-				Expect(fakeData.Get("public")).To(Equal(updatedProject.Public), "public")
+				Expect(fakeData.Get("public")).To(Equal(pt.BoolValue(updatedProject.Public)), "public")
 				Expect(fakeData.Get("velocity_averaged_over")).To(Equal(updatedProject.VelocityAveragedOver), "velocity_averaged_over")
+				Expect(fakeData.Get("start_date")).To(Equal(time.Time(*updatedProject.StartDate).Format("2006-01-02")), "start_date")
+				Expect(fakeData.Get("time_zone")).To(Equal(updatedProject.TimeZone.OlsonName), "time_zone")
+				Expect(fakeData.Get("week_start_day")).To(Equal(string(updatedProject.WeekStartDay)), "week_start_day")
 			})
 		})
 	})
@@ -254,17 +422,18 @@ func TestProject(t *testing.T) {
 func createControlDataset() (map[string]interface{}, pt.ProjectsRequest, *schema.Resource, *schema.ResourceData) {
 
 	projectResource := projects.NewProjectResource()
+	controlStartDate := pivotal.Date(time.Date(2019, time.January, 15, 0, 0, 0, 0, time.UTC))
 	controlProjects := pt.ProjectsRequest{
 		NewAccountName: "testing",
-		NoOwner:        false,
+		NoOwner:        true,
 		ProjectRequest: pt.ProjectRequest{
 			AccountID:                   1234,
-			AtomEnabled:                 false,
-			AutomaticPlanning:           false,
-			BugsAndChoresAreEstimatable: false,
+			AtomEnabled:                 pt.Bool(false),
+			AutomaticPlanning:           pt.Bool(false),
+			BugsAndChoresAreEstimatable: pt.Bool(false),
 			Description:                 "testing",
-			EnableIncomingEmails:        false,
-			EnableTasks:                 false,
+			EnableIncomingEmails:        pt.Bool(false),
+			EnableTasks:                 pt.Bool(false),
 			InitialVelocity:             10,
 			IterationLength:             1,
 			JoinAs:                      "testing",
@@ -273,22 +442,22 @@ func createControlDataset() (map[string]interface{}, pt.ProjectsRequest, *schema
 			PointScale:                   "testing",
 			ProfileContent:               "testing",
 			ProjectType:                  "testing",
-			Public:                       false,
-			StartDate:                    &pivotal.Date{},
+			Public:                       pt.Bool(false),
+			StartDate:                    &controlStartDate,
 			Status:                       "testing",
-			TimeZone:                     &pivotal.TimeZone{},
+			TimeZone:                     &pivotal.TimeZone{OlsonName: "America/New_York"},
 			VelocityAveragedOver:         2,
-			WeekStartDay:                 "testing",
+			WeekStartDay:                 pivotal.DayTuesday,
 		},
 	}
 	schemaMap := map[string]interface{}{
 		"account_id":                        controlProjects.AccountID,
-		"atom_enabled":                      controlProjects.AtomEnabled,
-		"automatic_planning":                controlProjects.AutomaticPlanning,
-		"bugs_and_chores_are_estimatable":   controlProjects.BugsAndChoresAreEstimatable,
+		"atom_enabled":                      pt.BoolValue(controlProjects.AtomEnabled),
+		"automatic_planning":                pt.BoolValue(controlProjects.AutomaticPlanning),
+		"bugs_and_chores_are_estimatable":   pt.BoolValue(controlProjects.BugsAndChoresAreEstimatable),
 		"description":                       controlProjects.Description,
-		"enable_incoming_emails":            controlProjects.EnableIncomingEmails,
-		"enable_tasks":                      controlProjects.EnableTasks,
+		"enable_incoming_emails":            pt.BoolValue(controlProjects.EnableIncomingEmails),
+		"enable_tasks":                      pt.BoolValue(controlProjects.EnableTasks),
 		"initial_velocity":                  controlProjects.InitialVelocity,
 		"iteration_length":                  controlProjects.IterationLength,
 		"join_as":                           controlProjects.JoinAs,
@@ -299,12 +468,12 @@ func createControlDataset() (map[string]interface{}, pt.ProjectsRequest, *schema
 		"point_scale":                       controlProjects.PointScale,
 		"profile_content":                   controlProjects.ProfileContent,
 		"project_type":                      controlProjects.ProjectType,
-		"public":                            controlProjects.Public,
-		"start_date":                        controlProjects.StartDate,
+		"public":                            pt.BoolValue(controlProjects.Public),
+		"start_date":                        "2019-01-15",
 		"status":                            controlProjects.Status,
-		"time_zone":                         controlProjects.TimeZone,
+		"time_zone":                         controlProjects.TimeZone.OlsonName,
 		"velocity_averaged_over":            controlProjects.VelocityAveragedOver,
-		"week_start_day":                    controlProjects.WeekStartDay,
+		"week_start_day":                    string(controlProjects.WeekStartDay),
 	}
 
 	fakeData := projectResource.TestResourceData()
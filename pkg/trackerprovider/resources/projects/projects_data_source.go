@@ -0,0 +1,150 @@
+package projects
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/validators"
+)
+
+var projectTypes = []string{"demo", "private", "public", "shared"}
+
+func NewProjectsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read:   readProjectsDataSource,
+		Schema: createProjectsDataSourceSchema(),
+	}
+}
+
+func readProjectsDataSource(d *schema.ResourceData, meta interface{}) error {
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		var err error
+		nameRegex, err = regexp.Compile(v.(string))
+		if err != nil {
+			return fmt.Errorf("invalid name_regex: %v", err)
+		}
+	}
+
+	client := meta.(pt.ClientCaller)
+	projectsResponse, _, err := client.ListProjects()
+	if err != nil {
+		return fmt.Errorf("list projects api call failed: %w", err)
+	}
+
+	accountID := d.Get("account_id").(int)
+	projectType := d.Get("project_type").(string)
+	public, filterPublic := d.GetOkExists("public")
+
+	var matches []*pt.Project
+	for _, project := range projectsResponse {
+		if accountID != 0 && project.AccountID != accountID {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(project.Name) {
+			continue
+		}
+
+		if projectType != "" && project.ProjectType != projectType {
+			continue
+		}
+
+		if filterPublic && project.Public != public.(bool) {
+			continue
+		}
+		matches = append(matches, project)
+	}
+
+	// tracker lists projects in no documented order, sorting by id keeps the
+	// list stable and appends new projects at its end
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].ID < matches[j].ID
+	})
+
+	projects := make([]interface{}, 0, len(matches))
+	projectIDs := make([]string, 0, len(matches))
+	for _, project := range matches {
+		projects = append(projects, map[string]interface{}{
+			"account_id":               project.AccountID,
+			"current_iteration_number": project.CurrentIterationNumber,
+			"description":              project.Description,
+			"id":                       project.ID,
+			"name":                     project.Name,
+			"project_type":             project.ProjectType,
+			"public":                   project.Public,
+		})
+		projectIDs = append(projectIDs, strconv.Itoa(project.ID))
+	}
+
+	d.Set("projects", projects)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(projectIDs, ","))))
+	return nil
+}
+
+func createProjectsDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: `
+				int in the response body.
+				 —  Only list the projects of this account.`,
+		},
+
+		"name_regex": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validators.Regexp,
+			Description: `
+				regular expression.
+				 —  Only list the projects whose name matches it.`,
+		},
+
+		"project_type": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validators.StringInSlice(projectTypes),
+			Description: `
+				enumerated string in the response body.
+				 —  Only list the projects of this type.
+				 Valid enumeration values: demo, private, public, shared`,
+		},
+
+		"public": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the response body.
+				 —  When given, only list the projects which are public (true)
+				 or not (false).`,
+		},
+
+		"projects": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":                       &schema.Schema{Type: schema.TypeInt, Computed: true},
+					"account_id":               &schema.Schema{Type: schema.TypeInt, Computed: true},
+					"current_iteration_number": &schema.Schema{Type: schema.TypeInt, Computed: true},
+					"description":              &schema.Schema{Type: schema.TypeString, Computed: true},
+					"name":                     &schema.Schema{Type: schema.TypeString, Computed: true},
+					"project_type":             &schema.Schema{Type: schema.TypeString, Computed: true},
+					"public":                   &schema.Schema{Type: schema.TypeBool, Computed: true},
+				},
+			},
+			Description: `
+				List of project objects in the response body.
+				 —  The matching projects sorted by id, each with id, name,
+				 account_id, description, project_type, public and
+				 current_iteration_number.`,
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package projects_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/projects"
+)
+
+func TestProjectsDataSource(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			projectsDataSource := projects.NewProjectsDataSource()
+			for k, v := range projectsDataSource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		controlProjects := []*pt.Project{
+			{ID: 30, Name: "platform-web", AccountID: 20, ProjectType: "private"},
+			{ID: 10, Name: "platform-api", AccountID: 20, ProjectType: "public", Public: true},
+			{ID: 20, Name: "marketing", AccountID: 20, ProjectType: "private"},
+			{ID: 40, Name: "platform-ops", AccountID: 99, ProjectType: "private"},
+		}
+		projectIDs := func(projects interface{}) []int {
+			ids := []int{}
+			for _, project := range projects.([]interface{}) {
+				ids = append(ids, project.(map[string]interface{})["id"].(int))
+			}
+			return ids
+		}
+
+		t.Run("when list fails", func(t *testing.T) {
+			projectsDataSource := projects.NewProjectsDataSource()
+			fakeData := projectsDataSource.TestResourceData()
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListProjectsReturns(nil, nil, fmt.Errorf("some erroor msg"))
+			err := projectsDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when no filter is given", func(t *testing.T) {
+			projectsDataSource := projects.NewProjectsDataSource()
+			fakeData := projectsDataSource.TestResourceData()
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListProjectsReturns(controlProjects, nil, nil)
+			err := projectsDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(projectIDs(fakeData.Get("projects"))).To(Equal([]int{10, 20, 30, 40}),
+				"it should list every project sorted by id",
+			)
+			Expect(fakeData.Id()).NotTo(BeEmpty())
+		})
+
+		t.Run("when the projects are filtered", func(t *testing.T) {
+			projectsDataSource := projects.NewProjectsDataSource()
+			fakeData := projectsDataSource.TestResourceData()
+			fakeData.Set("account_id", 20)
+			fakeData.Set("name_regex", "^platform-")
+			fakeData.Set("project_type", "private")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListProjectsReturns(controlProjects, nil, nil)
+			err := projectsDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(projectIDs(fakeData.Get("projects"))).To(Equal([]int{30}))
+			project := fakeData.Get("projects").([]interface{})[0].(map[string]interface{})
+			Expect(project["name"]).To(Equal("platform-web"))
+			Expect(project["account_id"]).To(Equal(20))
+		})
+
+		t.Run("when only private projects are wanted", func(t *testing.T) {
+			projectsDataSource := projects.NewProjectsDataSource()
+			fakeData := projectsDataSource.TestResourceData()
+			fakeData.Set("public", false)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.ListProjectsReturns(controlProjects, nil, nil)
+			err := projectsDataSource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(projectIDs(fakeData.Get("projects"))).To(Equal([]int{20, 30, 40}),
+				"it should honor an explicit false",
+			)
+		})
+
+		t.Run("when name_regex is not a valid regular expression", func(t *testing.T) {
+			projectsDataSource := projects.NewProjectsDataSource()
+			fakeData := projectsDataSource.TestResourceData()
+			fakeData.Set("name_regex", "platform-(")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := projectsDataSource.Read(fakeData, fakeClient)
+			Expect(err).To(MatchError(ContainSubstring("invalid name_regex")),
+				"it should error instead of panicking",
+			)
+			Expect(fakeClient.Invocations()).To(BeEmpty(),
+				"it should not call the tracker API",
+			)
+		})
+	})
+}
@@ -0,0 +1,159 @@
+package reviewtypes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/attrs"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+)
+
+func NewReviewTypeResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createReviewType,
+		Read:          readReviewType,
+		Delete:        deleteReviewType,
+		Update:        updateReviewType,
+		Exists:        existsReviewType,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createReviewType(d *schema.ResourceData, meta interface{}) error {
+	reviewTypeRequest := pt.ReviewTypeRequest{}
+	reviewTypeRequest.Hidden = attrs.ConfiguredBool(d, "hidden")
+	reviewTypeRequest.Name = d.Get("name").(string)
+	projectID := d.Get("project_id").(int)
+	client := meta.(pt.ClientCaller)
+	reviewTypeResponse, _, err := client.NewReviewType(projectID, reviewTypeRequest)
+	if err != nil {
+		return fmt.Errorf("creating new review type failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, reviewTypeResponse.ID))
+	return nil
+}
+
+func readReviewType(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, reviewTypeID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	reviewTypeResponse, _, err := client.GetReviewType(projectID, reviewTypeID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get review type api call failed: %w", err)
+	}
+
+	d.Set("hidden", pt.BoolValue(reviewTypeResponse.Hidden))
+	d.Set("name", reviewTypeResponse.Name)
+	d.Set("project_id", projectID)
+	d.SetId(ids.Format(projectID, reviewTypeResponse.ID))
+	return nil
+}
+
+func deleteReviewType(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, reviewTypeID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteReviewType(projectID, reviewTypeID)
+	if err != nil {
+		return fmt.Errorf("delete review type failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateReviewType(d *schema.ResourceData, meta interface{}) error {
+	reviewTypeRequest := pt.ReviewTypeRequest{}
+	reviewTypeRequest.Hidden = attrs.ChangedBool(d, "hidden")
+	reviewTypeRequest.Name = d.Get("name").(string)
+	client := meta.(pt.ClientCaller)
+	projectID, reviewTypeID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	reviewTypeResponse, _, err := client.UpdateReviewType(projectID, reviewTypeID, reviewTypeRequest)
+	if err != nil {
+		return fmt.Errorf("update review type failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, reviewTypeResponse.ID))
+	return nil
+}
+
+func existsReviewType(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, reviewTypeID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	reviewType, _, err := client.GetReviewType(projectID, reviewTypeID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get review type api call failed: %w", err)
+	}
+
+	if reviewType.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, error) {
+	values, err := ids.Parse(id, "project_id", "review_type_id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project the review type belongs to.`,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			Description: `
+				string[255] in the request body.
+				 —  The name of the review type, ie. "Code" or "QA".`,
+		},
+
+		"hidden": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the request body.
+				 —  When true, the review type can no longer be picked for
+				 new reviews, the existing reviews are kept.`,
+		},
+	}
+}
@@ -0,0 +1,224 @@
+package reviewtypes_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/reviewtypes"
+)
+
+func TestReviewType(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, reviewTypeResource, _ := createControlDataset()
+			for k, v := range reviewTypeResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlReviewTypeRequest, reviewTypeResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewReviewTypeReturns(&pt.ReviewType{}, nil, fmt.Errorf("some erroor msg"))
+			err := reviewTypeResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it creates a new review type", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewReviewTypeReturns(&pt.ReviewType{ID: 5678}, nil, nil)
+			err := reviewTypeResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should set the <project_id>/<review_type_id> id of the newly created resource",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, reviewTypeRequest := fakeClient.NewReviewTypeArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(reviewTypeRequest).To(Equal(controlReviewTypeRequest))
+			})
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, reviewTypeResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteReviewTypeReturns(nil, fmt.Errorf("some erroor msg"))
+			err := reviewTypeResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing review type", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := reviewTypeResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.DeleteReviewTypeCallCount()).To(Equal(1),
+				"it should call delete exactly once",
+			)
+			projectID, reviewTypeID := fakeClient.DeleteReviewTypeArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(reviewTypeID).To(Equal(5678))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, reviewTypeResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when review type was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewTypeReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := reviewTypeResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewTypeReturns(&pt.ReviewType{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := reviewTypeResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when review type exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewTypeReturns(&pt.ReviewType{ID: 5678}, nil, nil)
+			exists, err := reviewTypeResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, reviewTypeResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when review type was deleted outside terraform", func(t *testing.T) {
+			goneData := reviewTypeResource.TestResourceData()
+			goneData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewTypeReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := reviewTypeResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewTypeReturns(&pt.ReviewType{}, nil, fmt.Errorf("some erroor msg"))
+			err := reviewTypeResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing review type", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlReviewTypeResponse := &pt.ReviewType{
+				ID: 5678,
+				ReviewTypeRequest: pt.ReviewTypeRequest{
+					Name:   "Security",
+					Hidden: pt.Bool(true),
+				},
+			}
+			fakeClient.GetReviewTypeReturns(controlReviewTypeResponse, nil, nil)
+			err := reviewTypeResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("hidden")).To(BeTrue(), "hidden")
+				Expect(fakeData.Get("name")).To(Equal(controlReviewTypeResponse.Name), "name")
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Run("when update fails", func(t *testing.T) {
+			_, reviewTypeResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateReviewTypeReturns(&pt.ReviewType{}, nil, fmt.Errorf("some erroor msg"))
+			err := reviewTypeResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when the review type is shown again", func(t *testing.T) {
+			reviewTypeResource := reviewtypes.NewReviewTypeResource()
+			fakeData := reviewTypeResource.TestResourceData()
+			fakeData.SetId("1234/5678")
+			fakeData.Set("name", "Security")
+			fakeData.Set("hidden", false)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateReviewTypeReturns(&pt.ReviewType{ID: 5678}, nil, nil)
+			err := reviewTypeResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, reviewTypeID, reviewTypeRequest := fakeClient.UpdateReviewTypeArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(reviewTypeID).To(Equal(5678))
+			Expect(reviewTypeRequest.Hidden).To(Equal(pt.Bool(false)),
+				"it should send the explicit false",
+			)
+		})
+	})
+}
+
+func createControlDataset() (pt.ReviewTypeRequest, *schema.Resource, *schema.ResourceData) {
+	reviewTypeResource := reviewtypes.NewReviewTypeResource()
+	controlReviewType := pt.ReviewTypeRequest{
+		Name: "Security",
+	}
+	schemaMap := map[string]interface{}{
+		"name":       controlReviewType.Name,
+		"project_id": 1234,
+	}
+
+	fakeData := reviewTypeResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlReviewType, reviewTypeResource, fakeData
+}
@@ -0,0 +1,403 @@
+package stories
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/validators"
+)
+
+var storyTypes = []string{
+	pt.StoryTypeFeature,
+	pt.StoryTypeBug,
+	pt.StoryTypeChore,
+	pt.StoryTypeRelease,
+}
+
+var storyStates = []string{
+	"accepted",
+	"delivered",
+	"finished",
+	"started",
+	"rejected",
+	"planned",
+	"unstarted",
+	"unscheduled",
+}
+
+func NewStoryResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createStory,
+		Read:          readStory,
+		Delete:        deleteStory,
+		Update:        updateStory,
+		Exists:        existsStory,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createStory(d *schema.ResourceData, meta interface{}) error {
+	storyRequest := pt.StoryRequest{}
+	storyRequest.CurrentState = d.Get("current_state").(string)
+	storyRequest.Description = d.Get("description").(string)
+	storyRequest.Name = d.Get("name").(string)
+	storyRequest.RequestedByID = d.Get("requested_by_id").(int)
+	storyRequest.StoryType = d.Get("story_type").(string)
+	if v, ok := d.GetOkExists("estimate"); ok {
+		estimate := v.(float64)
+		storyRequest.Estimate = &estimate
+	}
+
+	if d.Get("labels").(*schema.Set).Len() > 0 {
+		storyRequest.Labels = toLabels(d.Get("labels").(*schema.Set))
+	}
+
+	if d.Get("owner_ids").(*schema.Set).Len() > 0 {
+		storyRequest.OwnerIDs = toOwnerIDs(d.Get("owner_ids").(*schema.Set))
+	}
+
+	deadline, err := toDeadline(d)
+	if err != nil {
+		return err
+	}
+	storyRequest.Deadline = deadline
+
+	projectID := d.Get("project_id").(int)
+	client := meta.(pt.ClientCaller)
+	storyResponse, _, err := client.NewStory(projectID, storyRequest)
+	if err != nil {
+		return fmt.Errorf("creating new story failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, storyResponse.ID))
+	return readStory(d, meta)
+}
+
+func readStory(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	storyResponse, _, err := client.GetStory(projectID, storyID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get story api call failed: %w", err)
+	}
+
+	labels := make([]interface{}, 0, len(storyResponse.Labels))
+	for _, label := range storyResponse.Labels {
+		labels = append(labels, label.Name)
+	}
+
+	ownerIDs := make([]interface{}, 0, len(storyResponse.OwnerIDs))
+	for _, ownerID := range storyResponse.OwnerIDs {
+		ownerIDs = append(ownerIDs, ownerID)
+	}
+
+	d.Set("current_state", storyResponse.CurrentState)
+	d.Set("deadline", fromDeadline(storyResponse.Deadline))
+	d.Set("description", storyResponse.Description)
+	d.Set("labels", labels)
+	d.Set("name", storyResponse.Name)
+	d.Set("owner_ids", ownerIDs)
+	d.Set("project_id", projectID)
+	d.Set("requested_by_id", storyResponse.RequestedByID)
+	d.Set("story_type", storyResponse.StoryType)
+	d.Set("url", storyResponse.URL)
+	if storyResponse.Estimate != nil {
+		d.Set("estimate", *storyResponse.Estimate)
+	} else {
+		d.Set("estimate", nil)
+	}
+	d.SetId(ids.Format(projectID, storyResponse.ID))
+	return nil
+}
+
+func deleteStory(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteStory(projectID, storyID)
+	if err != nil {
+		return fmt.Errorf("delete story failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateStory(d *schema.ResourceData, meta interface{}) error {
+	storyRequest := pt.StoryRequest{}
+	storyRequest.CurrentState = d.Get("current_state").(string)
+	storyRequest.Description = d.Get("description").(string)
+	storyRequest.ClearDescription = d.HasChange("description") && storyRequest.Description == ""
+	storyRequest.Name = d.Get("name").(string)
+	storyRequest.RequestedByID = d.Get("requested_by_id").(int)
+	storyRequest.StoryType = d.Get("story_type").(string)
+	if d.HasChange("estimate") {
+		if v, ok := d.GetOkExists("estimate"); ok {
+			estimate := v.(float64)
+			storyRequest.Estimate = &estimate
+		} else {
+			storyRequest.ClearEstimate = true
+		}
+	}
+
+	if d.HasChange("labels") {
+		storyRequest.Labels = toLabels(d.Get("labels").(*schema.Set))
+	}
+
+	if d.HasChange("owner_ids") {
+		storyRequest.OwnerIDs = toOwnerIDs(d.Get("owner_ids").(*schema.Set))
+	}
+
+	deadline, err := toDeadline(d)
+	if err != nil {
+		return err
+	}
+	storyRequest.Deadline = deadline
+	storyRequest.ClearDeadline = d.HasChange("deadline") && deadline == nil
+
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.UpdateStory(projectID, storyID, storyRequest)
+	if err != nil {
+		return fmt.Errorf("update story failed: %w", err)
+	}
+
+	return readStory(d, meta)
+}
+
+func existsStory(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	story, _, err := client.GetStory(projectID, storyID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get story api call failed: %w", err)
+	}
+
+	if story.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, error) {
+	values, err := ids.Parse(id, "project_id", "story_id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+func toLabels(set *schema.Set) *[]pt.LabelRequest {
+	labels := make([]pt.LabelRequest, 0, set.Len())
+	for _, v := range set.List() {
+		labels = append(labels, pt.LabelRequest{Name: v.(string)})
+	}
+	return &labels
+}
+
+func toOwnerIDs(set *schema.Set) *[]int {
+	ownerIDs := make([]int, 0, set.Len())
+	for _, v := range set.List() {
+		ownerIDs = append(ownerIDs, v.(int))
+	}
+	return &ownerIDs
+}
+
+// toDeadline returns the configured deadline, which tracker only accepts
+// as the release date of a release story.
+func toDeadline(d *schema.ResourceData) (*time.Time, error) {
+	value := d.Get("deadline").(string)
+	if value == "" {
+		return nil, nil
+	}
+
+	if d.Get("story_type").(string) != pt.StoryTypeRelease {
+		return nil, fmt.Errorf("deadline can only be set on stories of type %v", pt.StoryTypeRelease)
+	}
+
+	deadline, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("conversion of deadline failed: %v", err)
+	}
+	return &deadline, nil
+}
+
+func fromDeadline(deadline *time.Time) string {
+	if deadline == nil {
+		return ""
+	}
+	return deadline.UTC().Format(time.RFC3339)
+}
+
+// hashLabel hashes label names without regard to case, matching how
+// tracker compares them.
+func hashLabel(v interface{}) int {
+	return hashcode.String(strings.ToLower(v.(string)))
+}
+
+// suppressEquivalentDeadline ignores differences in how the same instant
+// is written, since tracker returns deadlines in UTC.
+func suppressEquivalentDeadline(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+func validateDeadline(v interface{}, k string) ([]string, []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be an RFC 3339 timestamp (2006-01-02T15:04:05Z): %v", k, err)}
+	}
+	return nil, nil
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project the story belongs to.`,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			Description: `
+				string[5000] in the request body.
+				 —  Title/summary of the story.`,
+		},
+
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: `
+				string[20000] in the request body.
+				 —  In-depth explanation of the story requirements.`,
+		},
+
+		"story_type": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validators.StringInSlice(storyTypes),
+			Description: `
+				enumerated string in the request body.
+				 —  Type of story.
+				 Valid enumeration values: feature, bug, chore, release`,
+		},
+
+		"current_state": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validators.StringInSlice(storyStates),
+			Description: `
+				enumerated string in the request body.
+				 —  Story's state of completion.
+				 Valid enumeration values: accepted, delivered, finished,
+				 started, rejected, planned, unstarted, unscheduled`,
+		},
+
+		"estimate": &schema.Schema{
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Description: `
+				float in the request body.
+				 —  Point value of the story. Only features, and bugs and
+				 chores when the project allows it, can be estimated.
+				 Removing it unestimates the story.`,
+		},
+
+		"labels": &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      hashLabel,
+			Description: `
+				List[string] in the request body.
+				 —  Names of the labels on the story. Labels that do not
+				 exist in the project yet are created.`,
+		},
+
+		"owner_ids": &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+			Description: `
+				List[int] in the request body.
+				 —  IDs of the people who own the story.`,
+		},
+
+		"requested_by_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+			Description: `
+				int in the request body.
+				 —  ID of the person who requested the story. Defaults to
+				 the owner of the access token.`,
+		},
+
+		"deadline": &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validateDeadline,
+			DiffSuppressFunc: suppressEquivalentDeadline,
+			Description: `
+				datetime in the request body.
+				 —  The release date of a release story, as an RFC 3339
+				 timestamp (ie. "2019-07-01T00:00:00Z"). Only valid on
+				 stories of type release.`,
+		},
+
+		"url": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: `
+				string in the response body.
+				 —  The url of the story in the tracker web interface.`,
+		},
+	}
+}
@@ -0,0 +1,377 @@
+package stories_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/stories"
+)
+
+func TestStory(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, storyResource, _ := createControlDataset()
+			for k, v := range storyResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+
+		t.Run("Should only allow tracker story types", func(t *testing.T) {
+			_, storyResource, _ := createControlDataset()
+			validate := storyResource.Schema["story_type"].ValidateFunc
+			for _, storyType := range []string{pt.StoryTypeFeature, pt.StoryTypeBug, pt.StoryTypeChore, pt.StoryTypeRelease} {
+				_, errs := validate(storyType, "story_type")
+				Expect(errs).To(BeEmpty(), storyType)
+			}
+			_, errs := validate("epic", "story_type")
+			Expect(errs).NotTo(BeEmpty(), "epic")
+		})
+
+		t.Run("Should only allow RFC 3339 deadlines", func(t *testing.T) {
+			_, storyResource, fakeData := createControlDataset()
+			deadline := storyResource.Schema["deadline"]
+			_, errs := deadline.ValidateFunc("2019-07-01T00:00:00Z", "deadline")
+			Expect(errs).To(BeEmpty())
+			_, errs = deadline.ValidateFunc("07/01/2019", "deadline")
+			Expect(errs).NotTo(BeEmpty())
+			Expect(deadline.DiffSuppressFunc("deadline", "2019-07-01T00:00:00Z", "2019-06-30T20:00:00-04:00", fakeData)).To(BeTrue(),
+				"it should ignore the same instant written in another zone",
+			)
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlStoryRequest, storyResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewStoryReturns(&pt.Story{}, nil, fmt.Errorf("some erroor msg"))
+			err := storyResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when a deadline is set on a story that is not a release", func(t *testing.T) {
+			_, storyResource, featureData := createControlDataset()
+			featureData.Set("story_type", pt.StoryTypeFeature)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := storyResource.Create(featureData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+			Expect(fakeClient.NewStoryCallCount()).To(Equal(0),
+				"it should not call the tracker api",
+			)
+		})
+
+		t.Run("when it creates a new story", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewStoryReturns(&pt.Story{ID: 5678}, nil, nil)
+			fakeClient.GetStoryReturns(&pt.Story{ID: 5678, URL: "https://www.pivotaltracker.com/story/show/5678"}, nil, nil)
+			err := storyResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should set the <project_id>/<story_id> id of the newly created resource",
+			)
+			Expect(fakeData.Get("url")).To(Equal("https://www.pivotaltracker.com/story/show/5678"),
+				"it should read back the computed values",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, storyRequest := fakeClient.NewStoryArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(storyRequest.Name).To(Equal(controlStoryRequest.Name))
+				Expect(storyRequest.Description).To(Equal(controlStoryRequest.Description))
+				Expect(storyRequest.StoryType).To(Equal(controlStoryRequest.StoryType))
+				Expect(storyRequest.CurrentState).To(Equal(controlStoryRequest.CurrentState))
+				Expect(storyRequest.RequestedByID).To(Equal(controlStoryRequest.RequestedByID))
+				Expect(storyRequest.Deadline.Equal(*controlStoryRequest.Deadline)).To(BeTrue(), "deadline")
+				Expect(*storyRequest.Labels).To(ConsistOf(*controlStoryRequest.Labels))
+				Expect(*storyRequest.OwnerIDs).To(ConsistOf(*controlStoryRequest.OwnerIDs))
+				Expect(storyRequest.Estimate).To(BeNil(),
+					"it should leave out an estimate that is not configured",
+				)
+			})
+		})
+
+		t.Run("when a zero estimate is configured", func(t *testing.T) {
+			_, storyResource, choreData := createControlDataset()
+			choreData.Set("story_type", pt.StoryTypeChore)
+			choreData.Set("deadline", "")
+			choreData.Set("estimate", 0.0)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewStoryReturns(&pt.Story{ID: 5678}, nil, nil)
+			fakeClient.GetStoryReturns(&pt.Story{ID: 5678}, nil, nil)
+			err := storyResource.Create(choreData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, storyRequest := fakeClient.NewStoryArgsForCall(0)
+			Expect(storyRequest.Estimate).NotTo(BeNil(),
+				"it should send the zero estimate",
+			)
+			Expect(*storyRequest.Estimate).To(Equal(0.0))
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, storyResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteStoryReturns(nil, fmt.Errorf("some erroor msg"))
+			err := storyResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing story", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := storyResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, storyID := fakeClient.DeleteStoryArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(storyID).To(Equal(5678))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, storyResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when story was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetStoryReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := storyResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetStoryReturns(&pt.Story{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := storyResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when story exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetStoryReturns(&pt.Story{ID: 5678}, nil, nil)
+			exists, err := storyResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, storyResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when story was deleted outside terraform", func(t *testing.T) {
+			goneData := storyResource.TestResourceData()
+			goneData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetStoryReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := storyResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetStoryReturns(&pt.Story{}, nil, fmt.Errorf("some erroor msg"))
+			err := storyResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing story", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			estimate := 2.0
+			deadline := time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)
+			controlStoryResponse := &pt.Story{
+				ID:            5678,
+				Name:          "rotate certificates",
+				Description:   "rotate the tls certificates of every environment",
+				StoryType:     pt.StoryTypeChore,
+				CurrentState:  "unstarted",
+				Estimate:      &estimate,
+				Labels:        []pt.Label{{ID: 1, LabelRequest: pt.LabelRequest{Name: "security"}}},
+				OwnerIDs:      []int{42, 43},
+				RequestedByID: 42,
+				Deadline:      &deadline,
+				URL:           "https://www.pivotaltracker.com/story/show/5678",
+			}
+			fakeClient.GetStoryReturns(controlStoryResponse, nil, nil)
+			err := storyResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("current_state")).To(Equal(controlStoryResponse.CurrentState), "current_state")
+				Expect(fakeData.Get("deadline")).To(Equal("2019-07-01T00:00:00Z"), "deadline")
+				Expect(fakeData.Get("description")).To(Equal(controlStoryResponse.Description), "description")
+				Expect(fakeData.Get("estimate")).To(Equal(estimate), "estimate")
+				Expect(fakeData.Get("labels").(*schema.Set).List()).To(ConsistOf("security"), "labels")
+				Expect(fakeData.Get("name")).To(Equal(controlStoryResponse.Name), "name")
+				Expect(fakeData.Get("owner_ids").(*schema.Set).List()).To(ConsistOf(42, 43), "owner_ids")
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+				Expect(fakeData.Get("requested_by_id")).To(Equal(controlStoryResponse.RequestedByID), "requested_by_id")
+				Expect(fakeData.Get("story_type")).To(Equal(controlStoryResponse.StoryType), "story_type")
+				Expect(fakeData.Get("url")).To(Equal(controlStoryResponse.URL), "url")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Run("when update fails", func(t *testing.T) {
+			_, storyResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateStoryReturns(&pt.Story{}, nil, fmt.Errorf("some erroor msg"))
+			err := storyResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when all labels are removed", func(t *testing.T) {
+			storyResource := stories.NewStoryResource()
+			fakeData := storyResource.TestResourceData()
+			fakeData.SetId("1234/5678")
+			fakeData.Set("name", "rotate certificates")
+			fakeData.Set("labels", []interface{}{})
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetStoryReturns(&pt.Story{ID: 5678}, nil, nil)
+			err := storyResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, storyID, storyRequest := fakeClient.UpdateStoryArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(storyID).To(Equal(5678))
+			Expect(storyRequest.Labels).NotTo(BeNil(),
+				"it should send the emptied list",
+			)
+			Expect(*storyRequest.Labels).To(BeEmpty())
+			Expect(storyRequest.OwnerIDs).To(BeNil(),
+				"it should leave out lists that did not change",
+			)
+		})
+
+		t.Run("when the description and the deadline are removed", func(t *testing.T) {
+			storyResource := stories.NewStoryResource()
+			fakeData := storyResource.TestResourceData()
+			fakeData.SetId("1234/5678")
+			fakeData.Set("name", "v2.0")
+			fakeData.Set("story_type", pt.StoryTypeRelease)
+			fakeData.Set("description", "")
+			fakeData.Set("deadline", "")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetStoryReturns(&pt.Story{ID: 5678}, nil, nil)
+			err := storyResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, _, storyRequest := fakeClient.UpdateStoryArgsForCall(0)
+			Expect(storyRequest.ClearDescription).To(BeTrue(),
+				"it should send the emptied description",
+			)
+			Expect(storyRequest.ClearDeadline).To(BeTrue(),
+				"it should send a null deadline",
+			)
+		})
+
+		t.Run("when the estimate is removed", func(t *testing.T) {
+			storyResource := stories.NewStoryResource()
+			existingData := storyResource.Data(&terraform.InstanceState{
+				ID: "1234/5678",
+				Attributes: map[string]string{
+					"name":     "rotate certificates",
+					"estimate": "2",
+				},
+			})
+			existingData.Set("estimate", nil)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetStoryReturns(&pt.Story{ID: 5678}, nil, nil)
+			err := storyResource.Update(existingData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, _, storyRequest := fakeClient.UpdateStoryArgsForCall(0)
+			Expect(storyRequest.ClearEstimate).To(BeTrue(),
+				"it should unestimate the story",
+			)
+			Expect(storyRequest.Estimate).To(BeNil())
+			Expect(existingData.Get("estimate")).To(Equal(0.0),
+				"it should read back the unestimated story",
+			)
+			_, ok := existingData.GetOkExists("estimate")
+			Expect(ok).To(BeFalse())
+		})
+	})
+}
+
+func createControlDataset() (pt.StoryRequest, *schema.Resource, *schema.ResourceData) {
+	storyResource := stories.NewStoryResource()
+	deadline := time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)
+	controlStory := pt.StoryRequest{
+		Name:          "v2.0",
+		Description:   "quarterly release",
+		StoryType:     pt.StoryTypeRelease,
+		CurrentState:  "unstarted",
+		Labels:        &[]pt.LabelRequest{{Name: "security"}, {Name: "customer-reported"}},
+		OwnerIDs:      &[]int{42},
+		RequestedByID: 42,
+		Deadline:      &deadline,
+	}
+	schemaMap := map[string]interface{}{
+		"current_state":   controlStory.CurrentState,
+		"deadline":        "2019-07-01T00:00:00Z",
+		"description":     controlStory.Description,
+		"labels":          []interface{}{"security", "customer-reported"},
+		"name":            controlStory.Name,
+		"owner_ids":       []interface{}{42},
+		"project_id":      1234,
+		"requested_by_id": controlStory.RequestedByID,
+		"story_type":      controlStory.StoryType,
+	}
+
+	fakeData := storyResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlStory, storyResource, fakeData
+}
@@ -0,0 +1,201 @@
+package storyreviews
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/validators"
+)
+
+var reviewStatuses = []string{
+	pt.ReviewStatusUnstarted,
+	pt.ReviewStatusInReview,
+	pt.ReviewStatusPass,
+	pt.ReviewStatusRevise,
+}
+
+func NewStoryReviewResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createStoryReview,
+		Read:          readStoryReview,
+		Delete:        deleteStoryReview,
+		Update:        updateStoryReview,
+		Exists:        existsStoryReview,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createStoryReview(d *schema.ResourceData, meta interface{}) error {
+	reviewRequest := pt.ReviewRequest{}
+	reviewRequest.ReviewTypeID = d.Get("review_type_id").(int)
+	reviewRequest.ReviewerID = d.Get("reviewer_id").(int)
+	reviewRequest.Status = d.Get("status").(string)
+	projectID := d.Get("project_id").(int)
+	storyID := d.Get("story_id").(int)
+	client := meta.(pt.ClientCaller)
+	reviewResponse, _, err := client.NewReview(projectID, storyID, reviewRequest)
+	if err != nil {
+		return fmt.Errorf("creating new story review failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, storyID, reviewResponse.ID))
+	d.Set("status", reviewResponse.Status)
+	return nil
+}
+
+func readStoryReview(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, reviewID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	reviewResponse, _, err := client.GetReview(projectID, storyID, reviewID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get story review api call failed: %w", err)
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("review_type_id", reviewResponse.ReviewTypeID)
+	d.Set("reviewer_id", reviewResponse.ReviewerID)
+	d.Set("status", reviewResponse.Status)
+	d.Set("story_id", storyID)
+	d.SetId(ids.Format(projectID, storyID, reviewResponse.ID))
+	return nil
+}
+
+func deleteStoryReview(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, reviewID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteReview(projectID, storyID, reviewID)
+	if err != nil {
+		return fmt.Errorf("delete story review failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateStoryReview(d *schema.ResourceData, meta interface{}) error {
+	reviewRequest := pt.ReviewRequest{}
+	if d.HasChange("reviewer_id") {
+		reviewRequest.ReviewerID = d.Get("reviewer_id").(int)
+		reviewRequest.ClearReviewerID = reviewRequest.ReviewerID == 0
+	}
+
+	if d.HasChange("status") {
+		reviewRequest.Status = d.Get("status").(string)
+	}
+
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, reviewID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	reviewResponse, _, err := client.UpdateReview(projectID, storyID, reviewID, reviewRequest)
+	if err != nil {
+		return fmt.Errorf("update story review failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, storyID, reviewResponse.ID))
+	return nil
+}
+
+func existsStoryReview(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, reviewID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	review, _, err := client.GetReview(projectID, storyID, reviewID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get story review api call failed: %w", err)
+	}
+
+	if review.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, int, error) {
+	values, err := ids.Parse(id, "project_id", "story_id", "review_id")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return values[0], values[1], values[2], nil
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project the story belongs to.`,
+		},
+
+		"story_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the story the review is required on.`,
+		},
+
+		"review_type_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request body.
+				 —  The ID of the review type, ie. the id of a
+				 pivotaltracker_review_type.`,
+		},
+
+		"reviewer_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: `
+				int in the request body.
+				 —  The ID of the person assigned to the review.`,
+		},
+
+		"status": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validators.StringInSlice(reviewStatuses),
+			Description: `
+				enumerated string in the request body.
+				 —  The progress of the review. When not given, tracker
+				 starts the review as unstarted and the status follows the
+				 reviewer's progress.
+				 Valid enumeration values: unstarted, in_review, pass, revise`,
+		},
+	}
+}
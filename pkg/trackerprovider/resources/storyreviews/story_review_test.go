@@ -0,0 +1,259 @@
+package storyreviews_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/storyreviews"
+)
+
+func TestStoryReview(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, storyReviewResource, _ := createControlDataset()
+			for k, v := range storyReviewResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlReviewRequest, storyReviewResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewReviewReturns(&pt.Review{}, nil, fmt.Errorf("some erroor msg"))
+			err := storyReviewResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it creates a new story review", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewReviewReturns(&pt.Review{ID: 91011, ReviewRequest: pt.ReviewRequest{Status: "unstarted"}}, nil, nil)
+			err := storyReviewResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678/91011"),
+				"it should set the <project_id>/<story_id>/<review_id> id of the newly created resource",
+			)
+			Expect(fakeData.Get("status")).To(Equal("unstarted"),
+				"it should keep the status tracker gave the review",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, storyID, reviewRequest := fakeClient.NewReviewArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(storyID).To(Equal(5678))
+				Expect(reviewRequest).To(Equal(controlReviewRequest))
+			})
+		})
+
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, storyReviewResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678/91011")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteReviewReturns(nil, fmt.Errorf("some erroor msg"))
+			err := storyReviewResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing story review", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := storyReviewResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, storyID, reviewID := fakeClient.DeleteReviewArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(storyID).To(Equal(5678))
+			Expect(reviewID).To(Equal(91011))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, storyReviewResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678/91011")
+		t.Run("when story review was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := storyReviewResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewReturns(&pt.Review{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := storyReviewResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when story review exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewReturns(&pt.Review{ID: 91011}, nil, nil)
+			exists, err := storyReviewResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, storyReviewResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678/91011")
+		t.Run("when the id is not a story review id", func(t *testing.T) {
+			badData := storyReviewResource.TestResourceData()
+			badData.SetId("1234/91011")
+			err := storyReviewResource.Read(badData, &ptfakes.FakeClientCaller{})
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when story review was deleted outside terraform", func(t *testing.T) {
+			goneData := storyReviewResource.TestResourceData()
+			goneData.SetId("1234/5678/91011")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := storyReviewResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetReviewReturns(&pt.Review{}, nil, fmt.Errorf("some erroor msg"))
+			err := storyReviewResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing story review", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlReviewResponse := &pt.Review{
+				ID: 91011,
+				ReviewRequest: pt.ReviewRequest{
+					ReviewTypeID: 42,
+					ReviewerID:   7,
+					Status:       "in_review",
+				},
+			}
+			fakeClient.GetReviewReturns(controlReviewResponse, nil, nil)
+			err := storyReviewResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+				Expect(fakeData.Get("review_type_id")).To(Equal(42), "review_type_id")
+				Expect(fakeData.Get("reviewer_id")).To(Equal(7), "reviewer_id")
+				Expect(fakeData.Get("status")).To(Equal("in_review"), "status")
+				Expect(fakeData.Get("story_id")).To(Equal(5678), "story_id")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Run("when update fails", func(t *testing.T) {
+			_, storyReviewResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/5678/91011")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateReviewReturns(&pt.Review{}, nil, fmt.Errorf("some erroor msg"))
+			err := storyReviewResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when the review is reassigned", func(t *testing.T) {
+			storyReviewResource := storyreviews.NewStoryReviewResource()
+			fakeData := storyReviewResource.TestResourceData()
+			fakeData.SetId("1234/5678/91011")
+			fakeData.Set("reviewer_id", 8)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateReviewReturns(&pt.Review{ID: 91011}, nil, nil)
+			err := storyReviewResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, storyID, reviewID, reviewRequest := fakeClient.UpdateReviewArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(storyID).To(Equal(5678))
+			Expect(reviewID).To(Equal(91011))
+			Expect(reviewRequest.ReviewerID).To(Equal(8))
+			Expect(reviewRequest.Status).To(BeEmpty(),
+				"it should leave the status to the reviewer",
+			)
+		})
+
+		t.Run("when the review is unassigned", func(t *testing.T) {
+			storyReviewResource := storyreviews.NewStoryReviewResource()
+			fakeData := storyReviewResource.TestResourceData()
+			fakeData.SetId("1234/5678/91011")
+			fakeData.Set("reviewer_id", 0)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateReviewReturns(&pt.Review{ID: 91011}, nil, nil)
+			err := storyReviewResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, _, _, reviewRequest := fakeClient.UpdateReviewArgsForCall(0)
+			Expect(reviewRequest.ClearReviewerID).To(BeTrue(),
+				"it should send a null reviewer_id",
+			)
+		})
+	})
+}
+
+func createControlDataset() (pt.ReviewRequest, *schema.Resource, *schema.ResourceData) {
+	storyReviewResource := storyreviews.NewStoryReviewResource()
+	controlReview := pt.ReviewRequest{
+		ReviewTypeID: 42,
+		ReviewerID:   7,
+	}
+	schemaMap := map[string]interface{}{
+		"project_id":     1234,
+		"review_type_id": controlReview.ReviewTypeID,
+		"reviewer_id":    controlReview.ReviewerID,
+		"story_id":       5678,
+	}
+
+	fakeData := storyReviewResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlReview, storyReviewResource, fakeData
+}
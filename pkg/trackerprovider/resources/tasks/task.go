@@ -0,0 +1,304 @@
+package tasks
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/mutexkv"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/attrs"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+)
+
+// storyMutexKV serializes the writes to the tasks of a story. Tracker
+// shifts the position of the other tasks whenever one is added, moved or
+// removed, so parallel writes would leave the positions depending on which
+// request happened to land first.
+var storyMutexKV = mutexkv.NewMutexKV()
+
+// configuredPositions remembers the configured position of every task this
+// provider process wrote, per story. Tracker clamps a position past the last
+// task and shifts the others on every insert, so placing each task once
+// leaves the order depending on the order terraform happened to create them
+// in. Placing all of them again, in ascending position, after every write
+// leaves each task where it is configured once the last one is written.
+var configuredPositions = &storyPositions{positions: map[string]map[int]int{}}
+
+type storyPositions struct {
+	mu        sync.Mutex
+	positions map[string]map[int]int
+}
+
+type taskPosition struct {
+	taskID   int
+	position int
+}
+
+// set remembers the position of a task, a position of 0 forgets it.
+func (p *storyPositions) set(story string, taskID int, position int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if position == 0 {
+		delete(p.positions[story], taskID)
+		return
+	}
+
+	if p.positions[story] == nil {
+		p.positions[story] = map[int]int{}
+	}
+	p.positions[story][taskID] = position
+}
+
+// sorted returns the remembered positions of a story in ascending order.
+func (p *storyPositions) sorted(story string) []taskPosition {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	sorted := make([]taskPosition, 0, len(p.positions[story]))
+	for taskID, position := range p.positions[story] {
+		sorted = append(sorted, taskPosition{taskID: taskID, position: position})
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].position == sorted[j].position {
+			return sorted[i].taskID < sorted[j].taskID
+		}
+		return sorted[i].position < sorted[j].position
+	})
+	return sorted
+}
+
+func NewTaskResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createTask,
+		Read:          readTask,
+		Delete:        deleteTask,
+		Update:        updateTask,
+		Exists:        existsTask,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createTask(d *schema.ResourceData, meta interface{}) error {
+	// the task is added after the existing tasks, placeTasks then moves it
+	// to its configured position
+	taskRequest := pt.TaskRequest{}
+	taskRequest.Complete = attrs.ConfiguredBool(d, "complete")
+	taskRequest.Description = d.Get("description").(string)
+	projectID := d.Get("project_id").(int)
+	storyID := d.Get("story_id").(int)
+	client := meta.(pt.ClientCaller)
+	lockStory(projectID, storyID)
+	defer unlockStory(projectID, storyID)
+
+	taskResponse, _, err := client.NewTask(projectID, storyID, taskRequest)
+	if err != nil {
+		return fmt.Errorf("creating new task failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, storyID, taskResponse.ID))
+	configuredPositions.set(ids.Format(projectID, storyID), taskResponse.ID, d.Get("position").(int))
+	return placeTasks(client, projectID, storyID)
+}
+
+func readTask(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, taskID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	taskResponse, _, err := client.GetTask(projectID, storyID, taskID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get task api call failed: %w", err)
+	}
+
+	d.Set("complete", pt.BoolValue(taskResponse.Complete))
+	d.Set("description", taskResponse.Description)
+	if _, ok := d.GetOk("position"); ok {
+		d.Set("position", taskResponse.Position)
+	}
+	d.Set("project_id", projectID)
+	d.Set("story_id", storyID)
+	d.SetId(ids.Format(projectID, storyID, taskResponse.ID))
+	return nil
+}
+
+func deleteTask(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, taskID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	lockStory(projectID, storyID)
+	defer unlockStory(projectID, storyID)
+	_, err = client.DeleteTask(projectID, storyID, taskID)
+	if err != nil {
+		return fmt.Errorf("delete task failed: %w", err)
+	}
+
+	configuredPositions.set(ids.Format(projectID, storyID), taskID, 0)
+
+	return nil
+}
+
+func updateTask(d *schema.ResourceData, meta interface{}) error {
+	taskRequest := pt.TaskRequest{}
+	taskRequest.Complete = attrs.ChangedBool(d, "complete")
+	taskRequest.Description = d.Get("description").(string)
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, taskID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	lockStory(projectID, storyID)
+	defer unlockStory(projectID, storyID)
+	taskResponse, _, err := client.UpdateTask(projectID, storyID, taskID, taskRequest)
+	if err != nil {
+		return fmt.Errorf("update task failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, storyID, taskResponse.ID))
+	if d.HasChange("position") {
+		configuredPositions.set(ids.Format(projectID, storyID), taskID, d.Get("position").(int))
+		return placeTasks(client, projectID, storyID)
+	}
+
+	return nil
+}
+
+func existsTask(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, storyID, taskID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	task, _, err := client.GetTask(projectID, storyID, taskID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get task api call failed: %w", err)
+	}
+
+	if task.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, int, error) {
+	values, err := ids.Parse(id, "project_id", "story_id", "task_id")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return values[0], values[1], values[2], nil
+}
+
+// placeTasks moves the tasks of a story with a configured position to it, in
+// ascending position. It must be called with the story locked.
+func placeTasks(client pt.ClientCaller, projectID int, storyID int) error {
+	for _, target := range configuredPositions.sorted(ids.Format(projectID, storyID)) {
+		tasks, _, err := client.ListTasks(projectID, storyID)
+		if err != nil {
+			return fmt.Errorf("list tasks api call failed: %w", err)
+		}
+
+		position, ok := positionOf(tasks, target.taskID)
+		if !ok {
+			configuredPositions.set(ids.Format(projectID, storyID), target.taskID, 0)
+			continue
+		}
+
+		if position == target.position {
+			continue
+		}
+
+		_, _, err = client.UpdateTask(projectID, storyID, target.taskID, pt.TaskRequest{Position: target.position})
+		if err != nil {
+			return fmt.Errorf("move task failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func positionOf(tasks []pt.Task, taskID int) (int, bool) {
+	for _, task := range tasks {
+		if task.ID == taskID {
+			return task.Position, true
+		}
+	}
+	return 0, false
+}
+
+func lockStory(projectID int, storyID int) {
+	storyMutexKV.Lock(ids.Format(projectID, storyID))
+}
+
+func unlockStory(projectID int, storyID int) {
+	storyMutexKV.Unlock(ids.Format(projectID, storyID))
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project the story belongs to.`,
+		},
+
+		"story_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the story the task belongs to.`,
+		},
+
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			Description: `
+				string[1000] in the request body.
+				 —  Full text of the task.`,
+		},
+
+		"complete": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: `
+				boolean in the request body.
+				 —  When true, the task has been completed.`,
+		},
+
+		"position": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: `
+				int in the request body.
+				 —  The position of the task within the story, starting at 1.
+				 When not given, the task is added after the existing tasks
+				 and its position is not tracked.`,
+		},
+	}
+}
@@ -0,0 +1,413 @@
+package tasks_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/tasks"
+)
+
+func TestTask(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, taskResource, _ := createControlDataset()
+			for k, v := range taskResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlTaskRequest, taskResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewTaskReturns(&pt.Task{}, nil, fmt.Errorf("some erroor msg"))
+			err := taskResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it creates a new task", func(t *testing.T) {
+			story := &fakeStory{taskIDs: []int{1, 2}}
+			fakeClient := story.client()
+			err := taskResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678/3"),
+				"it should set the <project_id>/<story_id>/<task_id> id of the newly created resource",
+			)
+			Expect(story.taskIDs).To(Equal([]int{3, 1, 2}),
+				"it should move the task to its configured position",
+			)
+			Expect(fakeData.Get("position")).To(Equal(1))
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, storyID, taskRequest := fakeClient.NewTaskArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(storyID).To(Equal(5678))
+				controlTaskRequest.Position = 0
+				Expect(taskRequest).To(Equal(controlTaskRequest),
+					"it should add the task after the existing tasks first",
+				)
+			})
+		})
+
+		t.Run("when no position is configured", func(t *testing.T) {
+			story := &fakeStory{taskIDs: []int{1, 2}}
+			fakeClient := story.client()
+			unplacedData := taskResource.TestResourceData()
+			unplacedData.Set("project_id", 1234)
+			unplacedData.Set("story_id", 5679)
+			unplacedData.Set("description", "request a laptop")
+			err := taskResource.Create(unplacedData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.UpdateTaskCallCount()).To(BeZero(),
+				"it should leave the task after the existing tasks",
+			)
+			Expect(story.taskIDs).To(Equal([]int{1, 2, 3}))
+
+			fakeClient.GetTaskReturns(&pt.Task{ID: 3, TaskRequest: pt.TaskRequest{Position: 3}}, nil, nil)
+			err = taskResource.Read(unplacedData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			_, ok := unplacedData.GetOk("position")
+			Expect(ok).To(BeFalse(),
+				"it should not track the position",
+			)
+		})
+
+		for _, order := range [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}} {
+			t.Run(fmt.Sprintf("when the tasks of a story are created in the order %v", order), func(t *testing.T) {
+				storyID := 6000 + order[0]*100 + order[1]*10 + order[2]
+				story := &fakeStory{}
+				fakeClient := story.client()
+				taskIDs := map[int]int{}
+				for _, position := range order {
+					_, taskResource, orderedData := createControlDataset()
+					orderedData.Set("story_id", storyID)
+					orderedData.Set("position", position)
+					err := taskResource.Create(orderedData, fakeClient)
+					Expect(err).NotTo(HaveOccurred(),
+						"it should not error",
+					)
+					_, _, taskID, _ := parseTaskID(orderedData.Id())
+					taskIDs[position] = taskID
+				}
+
+				Expect(story.taskIDs).To(Equal([]int{taskIDs[1], taskIDs[2], taskIDs[3]}),
+					"it should leave every task at its configured position",
+				)
+			})
+		}
+
+		t.Run("when several tasks of a story are created in parallel", func(t *testing.T) {
+			var inFlight, overlaps int32
+			story := &fakeStory{}
+			fakeClient := story.client()
+			newTask := fakeClient.NewTaskStub
+			fakeClient.NewTaskStub = func(projectID int, storyID int, task pt.TaskRequest) (*pt.Task, *http.Response, error) {
+				if atomic.AddInt32(&inFlight, 1) > 1 {
+					atomic.AddInt32(&overlaps, 1)
+				}
+				time.Sleep(5 * time.Millisecond)
+				atomic.AddInt32(&inFlight, -1)
+				return newTask(projectID, storyID, task)
+			}
+
+			var wg sync.WaitGroup
+			taskIDs := make([]int, 5)
+			for i := 1; i <= 5; i++ {
+				_, taskResource, parallelData := createControlDataset()
+				parallelData.Set("story_id", 7000)
+				parallelData.Set("position", i)
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					taskResource.Create(parallelData, fakeClient)
+					_, _, taskIDs[i-1], _ = parseTaskID(parallelData.Id())
+				}(i)
+			}
+			wg.Wait()
+			Expect(fakeClient.NewTaskCallCount()).To(Equal(5))
+			Expect(overlaps).To(BeZero(),
+				"it should not write to the tasks of one story concurrently",
+			)
+			Expect(story.taskIDs).To(Equal(taskIDs),
+				"it should leave every task at its configured position",
+			)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, taskResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678/91011")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteTaskReturns(nil, fmt.Errorf("some erroor msg"))
+			err := taskResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing task", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := taskResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, storyID, taskID := fakeClient.DeleteTaskArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(storyID).To(Equal(5678))
+			Expect(taskID).To(Equal(91011))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, taskResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678/91011")
+		t.Run("when task was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetTaskReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := taskResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetTaskReturns(&pt.Task{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := taskResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when task exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetTaskReturns(&pt.Task{ID: 91011}, nil, nil)
+			exists, err := taskResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, taskResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678/91011")
+		t.Run("when the id is not a task id", func(t *testing.T) {
+			badData := taskResource.TestResourceData()
+			badData.SetId("1234/91011")
+			err := taskResource.Read(badData, &ptfakes.FakeClientCaller{})
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when task was deleted outside terraform", func(t *testing.T) {
+			goneData := taskResource.TestResourceData()
+			goneData.SetId("1234/5678/91011")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetTaskReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := taskResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetTaskReturns(&pt.Task{}, nil, fmt.Errorf("some erroor msg"))
+			err := taskResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing task", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlTaskResponse := &pt.Task{
+				ID: 91011,
+				TaskRequest: pt.TaskRequest{
+					Description: "request a laptop",
+					Complete:    pt.Bool(true),
+					Position:    3,
+				},
+			}
+			fakeClient.GetTaskReturns(controlTaskResponse, nil, nil)
+			err := taskResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("complete")).To(BeTrue(), "complete")
+				Expect(fakeData.Get("description")).To(Equal(controlTaskResponse.Description), "description")
+				Expect(fakeData.Get("position")).To(Equal(controlTaskResponse.Position), "position")
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+				Expect(fakeData.Get("story_id")).To(Equal(5678), "story_id")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Run("when update fails", func(t *testing.T) {
+			_, taskResource, fakeData := createControlDataset()
+			fakeData.SetId("1234/5678/91011")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateTaskReturns(&pt.Task{}, nil, fmt.Errorf("some erroor msg"))
+			err := taskResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when the task is marked incomplete", func(t *testing.T) {
+			taskResource := tasks.NewTaskResource()
+			fakeData := taskResource.TestResourceData()
+			fakeData.SetId("1234/5678/91011")
+			fakeData.Set("description", "request a laptop")
+			fakeData.Set("complete", false)
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateTaskReturns(&pt.Task{ID: 91011}, nil, nil)
+			err := taskResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, storyID, taskID, taskRequest := fakeClient.UpdateTaskArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(storyID).To(Equal(5678))
+			Expect(taskID).To(Equal(91011))
+			Expect(taskRequest.Complete).To(Equal(pt.Bool(false)),
+				"it should send the explicit false",
+			)
+			Expect(taskRequest.Position).To(BeZero(),
+				"it should leave the position alone",
+			)
+		})
+
+		t.Run("when the task is moved", func(t *testing.T) {
+			taskResource := tasks.NewTaskResource()
+			story := &fakeStory{taskIDs: []int{1, 2, 3}}
+			fakeClient := story.client()
+			movedData := taskResource.TestResourceData()
+			movedData.SetId("1234/5680/3")
+			movedData.Set("description", "request a laptop")
+			movedData.Set("position", 1)
+			err := taskResource.Update(movedData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(story.taskIDs).To(Equal([]int{3, 1, 2}),
+				"it should move the task to its configured position",
+			)
+		})
+	})
+}
+
+// fakeStory orders the tasks of a story the way tracker does: a new task is
+// added last, and a task moved past the last position is placed last.
+type fakeStory struct {
+	sync.Mutex
+	taskIDs []int
+}
+
+func (story *fakeStory) client() *ptfakes.FakeClientCaller {
+	fakeClient := &ptfakes.FakeClientCaller{}
+	fakeClient.NewTaskStub = func(projectID int, storyID int, task pt.TaskRequest) (*pt.Task, *http.Response, error) {
+		story.Lock()
+		defer story.Unlock()
+		taskID := len(story.taskIDs) + 1
+		story.taskIDs = append(story.taskIDs, taskID)
+		return &pt.Task{ID: taskID, TaskRequest: pt.TaskRequest{Position: len(story.taskIDs)}}, nil, nil
+	}
+	fakeClient.ListTasksStub = func(projectID int, storyID int) ([]pt.Task, *http.Response, error) {
+		story.Lock()
+		defer story.Unlock()
+		tasks := []pt.Task{}
+		for i, taskID := range story.taskIDs {
+			tasks = append(tasks, pt.Task{ID: taskID, TaskRequest: pt.TaskRequest{Position: i + 1}})
+		}
+		return tasks, nil, nil
+	}
+	fakeClient.UpdateTaskStub = func(projectID int, storyID int, taskID int, task pt.TaskRequest) (*pt.Task, *http.Response, error) {
+		story.Lock()
+		defer story.Unlock()
+		if task.Position == 0 {
+			return &pt.Task{ID: taskID}, nil, nil
+		}
+
+		taskIDs := []int{}
+		for _, id := range story.taskIDs {
+			if id != taskID {
+				taskIDs = append(taskIDs, id)
+			}
+		}
+
+		position := task.Position
+		if position > len(taskIDs)+1 {
+			position = len(taskIDs) + 1
+		}
+		story.taskIDs = append(taskIDs[:position-1], append([]int{taskID}, taskIDs[position-1:]...)...)
+		return &pt.Task{ID: taskID, TaskRequest: pt.TaskRequest{Position: position}}, nil, nil
+	}
+	return fakeClient
+}
+
+func parseTaskID(id string) (int, int, int, error) {
+	var projectID, storyID, taskID int
+	_, err := fmt.Sscanf(id, "%d/%d/%d", &projectID, &storyID, &taskID)
+	return projectID, storyID, taskID, err
+}
+
+func createControlDataset() (pt.TaskRequest, *schema.Resource, *schema.ResourceData) {
+	taskResource := tasks.NewTaskResource()
+	controlTask := pt.TaskRequest{
+		Description: "request a laptop",
+		Complete:    pt.Bool(false),
+		Position:    1,
+	}
+	schemaMap := map[string]interface{}{
+		"complete":    false,
+		"description": controlTask.Description,
+		"position":    controlTask.Position,
+		"project_id":  1234,
+		"story_id":    5678,
+	}
+
+	fakeData := taskResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlTask, taskResource, fakeData
+}
@@ -0,0 +1,60 @@
+package validators
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// StringInSlice returns a SchemaValidateFunc which checks that the value is
+// one of the given enumeration values.
+func StringInSlice(valid []string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		for _, s := range valid {
+			if v == s {
+				return nil, nil
+			}
+		}
+		return nil, []error{fmt.Errorf("expected %s to be one of %v, got %s", k, valid, v)}
+	}
+}
+
+// HTTPURL is a SchemaValidateFunc which checks that the value is an
+// absolute http or https url.
+func HTTPURL(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	u, err := url.Parse(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a valid url: %v", k, err)}
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, []error{fmt.Errorf("expected %s to be an absolute http or https url, got %s", k, v)}
+	}
+	return nil, nil
+}
+
+// Regexp is a SchemaValidateFunc which checks that the value is a valid
+// regular expression.
+func Regexp(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := regexp.Compile(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a valid regular expression: %v", k, err)}
+	}
+	return nil, nil
+}
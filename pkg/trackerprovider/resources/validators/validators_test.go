@@ -0,0 +1,68 @@
+package validators_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/validators"
+)
+
+func TestValidators(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("StringInSlice", func(t *testing.T) {
+		validate := validators.StringInSlice([]string{"owner", "member"})
+		t.Run("when the value is valid", func(t *testing.T) {
+			_, errs := validate("owner", "role")
+			Expect(errs).To(BeEmpty(),
+				"it should not error",
+			)
+		})
+
+		t.Run("when the value is not valid", func(t *testing.T) {
+			_, errs := validate("admin", "role")
+			Expect(errs).To(HaveLen(1),
+				"it should error",
+			)
+		})
+
+		t.Run("when the value is not a string", func(t *testing.T) {
+			_, errs := validate(1, "role")
+			Expect(errs).To(HaveLen(1),
+				"it should error",
+			)
+		})
+	})
+
+	t.Run("HTTPURL", func(t *testing.T) {
+		t.Run("when the value is valid", func(t *testing.T) {
+			for _, valid := range []string{"https://www.pivotaltracker.com/services/v5/", "http://localhost:8080"} {
+				_, errs := validators.HTTPURL(valid, "url")
+				Expect(errs).To(BeEmpty(), valid)
+			}
+		})
+
+		t.Run("when the value is not valid", func(t *testing.T) {
+			for _, invalid := range []interface{}{"", "localhost:8080", "ftp://example.com", "http://[::1", 1} {
+				_, errs := validators.HTTPURL(invalid, "url")
+				Expect(errs).To(HaveLen(1), fmt.Sprint(invalid))
+			}
+		})
+	})
+
+	t.Run("Regexp", func(t *testing.T) {
+		t.Run("when the value is valid", func(t *testing.T) {
+			_, errs := validators.Regexp("^platform-.*$", "name_regex")
+			Expect(errs).To(BeEmpty(),
+				"it should not error",
+			)
+		})
+
+		t.Run("when the value is not valid", func(t *testing.T) {
+			for _, invalid := range []interface{}{"platform-(", 1} {
+				_, errs := validators.Regexp(invalid, "name_regex")
+				Expect(errs).To(HaveLen(1), fmt.Sprint(invalid))
+			}
+		})
+	})
+}
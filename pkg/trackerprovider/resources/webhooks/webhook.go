@@ -0,0 +1,160 @@
+package webhooks
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/ids"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/validators"
+)
+
+func NewWebhookResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createWebhook,
+		Read:          readWebhook,
+		Delete:        deleteWebhook,
+		Update:        updateWebhook,
+		Exists:        existsWebhook,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createWebhook(d *schema.ResourceData, meta interface{}) error {
+	webhookRequest := pt.WebhookRequest{}
+	webhookRequest.WebhookURL = d.Get("webhook_url").(string)
+	webhookRequest.WebhookVersion = d.Get("webhook_version").(string)
+	projectID := d.Get("project_id").(int)
+	client := meta.(pt.ClientCaller)
+	webhookResponse, _, err := client.NewWebhook(projectID, webhookRequest)
+	if err != nil {
+		return fmt.Errorf("creating new webhook failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, webhookResponse.ID))
+	return nil
+}
+
+func readWebhook(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, webhookID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	webhookResponse, _, err := client.GetWebhook(projectID, webhookID)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get webhook api call failed: %w", err)
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("webhook_url", webhookResponse.WebhookURL)
+	d.Set("webhook_version", webhookResponse.WebhookVersion)
+	d.SetId(ids.Format(projectID, webhookResponse.ID))
+	return nil
+}
+
+func deleteWebhook(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	projectID, webhookID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteWebhook(projectID, webhookID)
+	if err != nil {
+		return fmt.Errorf("delete webhook failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateWebhook(d *schema.ResourceData, meta interface{}) error {
+	webhookRequest := pt.WebhookRequest{}
+	webhookRequest.WebhookURL = d.Get("webhook_url").(string)
+	webhookRequest.WebhookVersion = d.Get("webhook_version").(string)
+	client := meta.(pt.ClientCaller)
+	projectID, webhookID, err := parseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	webhookResponse, _, err := client.UpdateWebhook(projectID, webhookID, webhookRequest)
+	if err != nil {
+		return fmt.Errorf("update webhook failed: %w", err)
+	}
+
+	d.SetId(ids.Format(projectID, webhookResponse.ID))
+	return nil
+}
+
+func existsWebhook(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	projectID, webhookID, err := parseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	webhook, _, err := client.GetWebhook(projectID, webhookID)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get webhook api call failed: %w", err)
+	}
+
+	if webhook.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func parseID(id string) (int, int, error) {
+	values, err := ids.Parse(id, "project_id", "webhook_id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+			Description: `
+				int in the request path.
+				 —  The ID of the project whose activity is posted to the webhook.`,
+		},
+
+		"webhook_url": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validators.HTTPURL,
+			Description: `
+				string[255] in the request body.
+				 —  The url tracker posts the project's activity to.`,
+		},
+
+		"webhook_version": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "v5",
+			Description: `
+				string in the request body.
+				 —  The version of the activity format posted to the url.`,
+		},
+	}
+}
@@ -0,0 +1,233 @@
+package webhooks_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/webhooks"
+)
+
+func TestWebhook(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, webhookResource, _ := createControlDataset()
+			for k, v := range webhookResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+
+		t.Run("Should only allow http urls", func(t *testing.T) {
+			_, webhookResource, _ := createControlDataset()
+			validate := webhookResource.Schema["webhook_url"].ValidateFunc
+			_, errs := validate("https://activity.example.com/tracker", "webhook_url")
+			Expect(errs).To(BeEmpty())
+			_, errs = validate("activity.example.com/tracker", "webhook_url")
+			Expect(errs).NotTo(BeEmpty())
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlWebhookRequest, webhookResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewWebhookReturns(&pt.Webhook{}, nil, fmt.Errorf("some erroor msg"))
+			err := webhookResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it creates a new webhook", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewWebhookReturns(&pt.Webhook{ID: 5678}, nil, nil)
+			err := webhookResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should set the <project_id>/<webhook_id> id of the newly created resource",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				projectID, webhookRequest := fakeClient.NewWebhookArgsForCall(0)
+				Expect(projectID).To(Equal(1234))
+				Expect(webhookRequest).To(Equal(controlWebhookRequest))
+			})
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, webhookResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteWebhookReturns(nil, fmt.Errorf("some erroor msg"))
+			err := webhookResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing webhook", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := webhookResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.DeleteWebhookCallCount()).To(Equal(1),
+				"it should call delete exactly once",
+			)
+			projectID, webhookID := fakeClient.DeleteWebhookArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(webhookID).To(Equal(5678))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, webhookResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when webhook was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWebhookReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := webhookResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWebhookReturns(&pt.Webhook{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := webhookResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when webhook exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWebhookReturns(&pt.Webhook{ID: 5678}, nil, nil)
+			exists, err := webhookResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, webhookResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when webhook was deleted outside terraform", func(t *testing.T) {
+			goneData := webhookResource.TestResourceData()
+			goneData.SetId("1234/5678")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWebhookReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := webhookResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWebhookReturns(&pt.Webhook{}, nil, fmt.Errorf("some erroor msg"))
+			err := webhookResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing webhook", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlWebhookResponse := &pt.Webhook{
+				ID: 5678,
+				WebhookRequest: pt.WebhookRequest{
+					WebhookURL:     "https://edited-in-the-ui.example.com/tracker",
+					WebhookVersion: "v5",
+				},
+			}
+			fakeClient.GetWebhookReturns(controlWebhookResponse, nil, nil)
+			err := webhookResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("project_id")).To(Equal(1234), "project_id")
+				Expect(fakeData.Get("webhook_url")).To(Equal(controlWebhookResponse.WebhookURL),
+					"it should pick up a url edited outside terraform",
+				)
+				Expect(fakeData.Get("webhook_version")).To(Equal(controlWebhookResponse.WebhookVersion), "webhook_version")
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		_, webhookResource, fakeData := createControlDataset()
+		fakeData.SetId("1234/5678")
+		t.Run("when update fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateWebhookReturns(&pt.Webhook{}, nil, fmt.Errorf("some erroor msg"))
+			err := webhookResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it changes the url in place", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeData.Set("webhook_url", "https://new-activity.example.com/tracker")
+			fakeClient.UpdateWebhookReturns(&pt.Webhook{ID: 5678}, nil, nil)
+			err := webhookResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			projectID, webhookID, webhookRequest := fakeClient.UpdateWebhookArgsForCall(0)
+			Expect(projectID).To(Equal(1234))
+			Expect(webhookID).To(Equal(5678))
+			Expect(webhookRequest.WebhookURL).To(Equal("https://new-activity.example.com/tracker"))
+			Expect(fakeData.Id()).To(Equal("1234/5678"),
+				"it should keep the id of the resource",
+			)
+		})
+	})
+}
+
+func createControlDataset() (pt.WebhookRequest, *schema.Resource, *schema.ResourceData) {
+	webhookResource := webhooks.NewWebhookResource()
+	controlWebhook := pt.WebhookRequest{
+		WebhookURL:     "https://activity.example.com/tracker",
+		WebhookVersion: "v5",
+	}
+	schemaMap := map[string]interface{}{
+		"project_id":      1234,
+		"webhook_url":     controlWebhook.WebhookURL,
+		"webhook_version": controlWebhook.WebhookVersion,
+	}
+
+	fakeData := webhookResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlWebhook, webhookResource, fakeData
+}
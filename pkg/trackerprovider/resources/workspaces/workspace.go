@@ -0,0 +1,165 @@
+package workspaces
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+)
+
+func NewWorkspaceResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        createWorkspace,
+		Read:          readWorkspace,
+		Delete:        deleteWorkspace,
+		Update:        updateWorkspace,
+		Exists:        existsWorkspace,
+		SchemaVersion: 1,
+		Schema:        createSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func createWorkspace(d *schema.ResourceData, meta interface{}) error {
+	workspaceRequest := pt.WorkspaceRequest{}
+	workspaceRequest.Name = d.Get("name").(string)
+	workspaceRequest.ProjectIDs = toProjectIDs(d.Get("project_ids").([]interface{}))
+	client := meta.(pt.ClientCaller)
+	workspaceResponse, _, err := client.NewWorkspace(workspaceRequest)
+	if err != nil {
+		return fmt.Errorf("creating new workspace failed: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(workspaceResponse.ID))
+	return nil
+}
+
+func readWorkspace(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("conversion of id failed: %v", err)
+	}
+
+	workspaceResponse, _, err := client.GetWorkspace(id)
+	if pt.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("get workspace api call failed: %w", err)
+	}
+
+	projectIDs := make([]interface{}, 0, len(workspaceResponse.ProjectIDs))
+	for _, projectID := range workspaceResponse.ProjectIDs {
+		projectIDs = append(projectIDs, projectID)
+	}
+
+	d.Set("name", workspaceResponse.Name)
+	d.Set("person_id", workspaceResponse.PersonID)
+	d.Set("project_ids", projectIDs)
+	d.SetId(strconv.Itoa(workspaceResponse.ID))
+	return nil
+}
+
+func deleteWorkspace(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(pt.ClientCaller)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("conversion of id failed: %v", err)
+	}
+
+	_, err = client.DeleteWorkspace(id)
+	if err != nil {
+		return fmt.Errorf("delete workspace failed: %w", err)
+	}
+
+	return nil
+}
+
+func updateWorkspace(d *schema.ResourceData, meta interface{}) error {
+	workspaceRequest := pt.WorkspaceRequest{}
+	workspaceRequest.Name = d.Get("name").(string)
+	if d.HasChange("project_ids") {
+		workspaceRequest.ProjectIDs = toProjectIDs(d.Get("project_ids").([]interface{}))
+	}
+
+	client := meta.(pt.ClientCaller)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("conversion of id failed: %v", err)
+	}
+
+	workspaceResponse, _, err := client.UpdateWorkspace(id, workspaceRequest)
+	if err != nil {
+		return fmt.Errorf("update workspace failed: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(workspaceResponse.ID))
+	return nil
+}
+
+func existsWorkspace(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(pt.ClientCaller)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return false, fmt.Errorf("conversion of id failed: %v", err)
+	}
+
+	workspace, _, err := client.GetWorkspace(id)
+	if pt.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("get workspace api call failed: %w", err)
+	}
+
+	if workspace.ID > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+func toProjectIDs(values []interface{}) *[]int {
+	projectIDs := make([]int, 0, len(values))
+	for _, v := range values {
+		projectIDs = append(projectIDs, v.(int))
+	}
+	return &projectIDs
+}
+
+func createSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			Description: `
+				string[255] in the request body.
+				 —  The name of the workspace.`,
+		},
+
+		"project_ids": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+			Description: `
+				List[int] in the request body.
+				 —  The IDs of the projects in the workspace, in the order
+				 they are shown in.`,
+		},
+
+		"person_id": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+			Description: `
+				int in the response body.
+				 —  The ID of the person owning the workspace, the owner of
+				 the access token.`,
+		},
+	}
+}
@@ -0,0 +1,226 @@
+package workspaces_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/pt/ptfakes"
+	"github.com/xchapter7x/terraform-provider-pivotaltracker/pkg/trackerprovider/resources/workspaces"
+)
+
+func TestWorkspace(t *testing.T) {
+	RegisterTestingT(t)
+	t.Run("Schema", func(t *testing.T) {
+		t.Run("Should describe every field", func(t *testing.T) {
+			_, workspaceResource, _ := createControlDataset()
+			for k, v := range workspaceResource.Schema {
+				Expect(v).NotTo(BeNil(),
+					"schema value is not valid",
+				)
+				Expect(v.Description).NotTo(BeEmpty(),
+					fmt.Sprintf("we shouldnt add elements without having a description (%v)", k),
+				)
+			}
+		})
+
+		t.Run("Should update the projects in place", func(t *testing.T) {
+			_, workspaceResource, _ := createControlDataset()
+			Expect(workspaceResource.Schema["project_ids"].ForceNew).To(BeFalse())
+			Expect(workspaceResource.Schema["project_ids"].Type).To(Equal(schema.TypeList),
+				"it should keep the order of the projects",
+			)
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		controlWorkspaceRequest, workspaceResource, fakeData := createControlDataset()
+		t.Run("when create fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewWorkspaceReturns(&pt.Workspace{}, nil, fmt.Errorf("some erroor msg"))
+			err := workspaceResource.Create(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it creates a new workspace", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.NewWorkspaceReturns(&pt.Workspace{ID: 1234}, nil, nil)
+			err := workspaceResource.Create(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeData.Id()).To(Equal("1234"),
+				"it should set the id of the newly created resource",
+			)
+
+			t.Run("it should call the client with the same values set in the resource data", func(t *testing.T) {
+				workspaceRequest := fakeClient.NewWorkspaceArgsForCall(0)
+				Expect(workspaceRequest).To(Equal(controlWorkspaceRequest))
+			})
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, workspaceResource, fakeData := createControlDataset()
+		fakeData.SetId("1234")
+		t.Run("when delete fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.DeleteWorkspaceReturns(nil, fmt.Errorf("some erroor msg"))
+			err := workspaceResource.Delete(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it deletes an existing workspace", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			err := workspaceResource.Delete(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(fakeClient.DeleteWorkspaceArgsForCall(0)).To(Equal(1234))
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, workspaceResource, fakeData := createControlDataset()
+		fakeData.SetId("1234")
+		t.Run("when workspace was deleted outside terraform", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWorkspaceReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			exists, err := workspaceResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeFalse(),
+				"it should return false so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when exists call fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWorkspaceReturns(&pt.Workspace{}, nil, fmt.Errorf("some erroor msg"))
+			_, err := workspaceResource.Exists(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when workspace exists", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWorkspaceReturns(&pt.Workspace{ID: 1234}, nil, nil)
+			exists, err := workspaceResource.Exists(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(exists).To(BeTrue(),
+				"it should return true",
+			)
+		})
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, workspaceResource, fakeData := createControlDataset()
+		fakeData.SetId("1234")
+		t.Run("when workspace was deleted outside terraform", func(t *testing.T) {
+			goneData := workspaceResource.TestResourceData()
+			goneData.SetId("1234")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWorkspaceReturns(nil, nil, fmt.Errorf("failed calling service: %w", &pt.Error{StatusCode: http.StatusNotFound}))
+			err := workspaceResource.Read(goneData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			Expect(goneData.Id()).To(BeEmpty(),
+				"it should clear the id so terraform plans a recreate",
+			)
+		})
+
+		t.Run("when read fails", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.GetWorkspaceReturns(&pt.Workspace{}, nil, fmt.Errorf("some erroor msg"))
+			err := workspaceResource.Read(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when it reads an existing workspace", func(t *testing.T) {
+			fakeClient := &ptfakes.FakeClientCaller{}
+			controlWorkspaceResponse := &pt.Workspace{
+				ID:         1234,
+				PersonID:   42,
+				Name:       "platform",
+				ProjectIDs: []int{99, 11, 55},
+			}
+			fakeClient.GetWorkspaceReturns(controlWorkspaceResponse, nil, nil)
+			err := workspaceResource.Read(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+
+			t.Run("it set the resource data with the values from the tracker API", func(t *testing.T) {
+				Expect(fakeData.Get("name")).To(Equal(controlWorkspaceResponse.Name), "name")
+				Expect(fakeData.Get("person_id")).To(Equal(42), "person_id")
+				Expect(fakeData.Get("project_ids")).To(Equal([]interface{}{99, 11, 55}),
+					"it should keep the order of the projects",
+				)
+			})
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Run("when update fails", func(t *testing.T) {
+			_, workspaceResource, fakeData := createControlDataset()
+			fakeData.SetId("1234")
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateWorkspaceReturns(&pt.Workspace{}, nil, fmt.Errorf("some erroor msg"))
+			err := workspaceResource.Update(fakeData, fakeClient)
+			Expect(err).To(HaveOccurred(),
+				"it should error",
+			)
+		})
+
+		t.Run("when the last project is removed", func(t *testing.T) {
+			workspaceResource := workspaces.NewWorkspaceResource()
+			fakeData := workspaceResource.TestResourceData()
+			fakeData.SetId("1234")
+			fakeData.Set("name", "platform")
+			fakeData.Set("project_ids", []interface{}{})
+			fakeClient := &ptfakes.FakeClientCaller{}
+			fakeClient.UpdateWorkspaceReturns(&pt.Workspace{ID: 1234}, nil, nil)
+			err := workspaceResource.Update(fakeData, fakeClient)
+			Expect(err).NotTo(HaveOccurred(),
+				"it should not error",
+			)
+			workspaceID, workspaceRequest := fakeClient.UpdateWorkspaceArgsForCall(0)
+			Expect(workspaceID).To(Equal(1234))
+			Expect(workspaceRequest.ProjectIDs).To(Equal(&[]int{}),
+				"it should send the emptied list",
+			)
+		})
+	})
+}
+
+func createControlDataset() (pt.WorkspaceRequest, *schema.Resource, *schema.ResourceData) {
+	workspaceResource := workspaces.NewWorkspaceResource()
+	controlWorkspace := pt.WorkspaceRequest{
+		Name:       "platform",
+		ProjectIDs: &[]int{99, 11, 55},
+	}
+	schemaMap := map[string]interface{}{
+		"name":        controlWorkspace.Name,
+		"project_ids": []interface{}{99, 11, 55},
+	}
+
+	fakeData := workspaceResource.TestResourceData()
+	for k, v := range schemaMap {
+		fakeData.Set(k, v)
+	}
+	return controlWorkspace, workspaceResource, fakeData
+}
@@ -29,7 +29,7 @@ func TestClientIntegrations(t *testing.T) {
 		memberEmail := u.String() + "@devnull.io"
 		memberInitials := "JC"
 		memberName := "mbr-" + u.String()
-		client := pt.NewClient(token)
+		client := pt.NewClient(pt.Config{APIToken: token})
 
 		t.Log("Calling ListAccountMembers endpoint")
 		memberList, _, err := client.ListAccountMembers(accountID)
@@ -78,14 +78,14 @@ func TestClientIntegrations(t *testing.T) {
 		)
 
 		t.Log("Update the member")
-		Expect(member.ProjectCreator).To(BeFalse(),
+		Expect(pt.BoolValue(member.ProjectCreator)).To(BeFalse(),
 			"insure old value is set properly",
 		)
-		modifiedMember, _, err := client.UpdateAccountMember(accountID, member.Person.ID, pt.AccountMemberRequest{ProjectCreator: true})
+		modifiedMember, _, err := client.UpdateAccountMember(accountID, member.Person.ID, pt.AccountMemberRequest{ProjectCreator: pt.Bool(true)})
 		Expect(err).NotTo(HaveOccurred(),
 			"call to update member should not fail",
 		)
-		Expect(modifiedMember.ProjectCreator).To(BeTrue(),
+		Expect(pt.BoolValue(modifiedMember.ProjectCreator)).To(BeTrue(),
 			"member should not have old attribute",
 		)
 
@@ -123,7 +123,7 @@ func TestClientIntegrations(t *testing.T) {
 		u := uuid.NewV4()
 		projectName := "prj-" + u.String()
 		modifiedProjectName := "mod-" + u.String()
-		client := pt.NewClient(token)
+		client := pt.NewClient(pt.Config{APIToken: token})
 
 		t.Log("Calling ListProjects endpoint")
 		projectList, _, err := client.ListProjects()